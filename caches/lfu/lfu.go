@@ -0,0 +1,226 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package lfu implements a fixed capacity cache that evicts the least frequently used element.
+//
+// It is backed by a hash table to find elements and a doubly-linked list per access count (frequency bucket),
+// so that Get and Put run in O(1). Among elements with the same count, the least recently used one is evicted.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Least_frequently_used
+package lfu
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Cache holds the elements in a hash table of nodes linked into buckets by their access count.
+type Cache[T comparable, P any] struct {
+	table    map[T]*node[T, P]
+	buckets  map[int]*bucket[T, P]
+	minCount int
+	capacity int
+	onEvict  func(key T, value P)
+}
+
+type node[T comparable, P any] struct {
+	key   T
+	value P
+	count int
+	prev  *node[T, P]
+	next  *node[T, P]
+}
+
+// bucket links the nodes sharing an access count, most recently used first.
+type bucket[T comparable, P any] struct {
+	head *node[T, P]
+	tail *node[T, P]
+}
+
+// New instantiates a LFU cache holding at most capacity elements.
+// Panics if capacity is not positive.
+func New[T comparable, P any](capacity int) *Cache[T, P] {
+	return NewWithEvict[T, P](capacity, nil)
+}
+
+// NewWithEvict instantiates a LFU cache holding at most capacity elements,
+// which calls onEvict with every element evicted to make room for a new one.
+// Panics if capacity is not positive.
+func NewWithEvict[T comparable, P any](capacity int, onEvict func(key T, value P)) *Cache[T, P] {
+	if capacity <= 0 {
+		panic("capacity must be positive")
+	}
+	return &Cache[T, P]{
+		table:    make(map[T]*node[T, P]),
+		buckets:  make(map[int]*bucket[T, P]),
+		capacity: capacity,
+		onEvict:  onEvict,
+	}
+}
+
+// Get searches the element in the cache by key and returns its value or nil if key is not found in cache.
+// Second return parameter is true if key was found, otherwise false.
+// A found element has its access count incremented.
+func (cache *Cache[T, P]) Get(key T) (value P, found bool) {
+	n, found := cache.table[key]
+	if !found {
+		return value, false
+	}
+	cache.touch(n)
+	return n.value, true
+}
+
+// Put inserts key-value pair into the cache with an access count of 1.
+// If the key is already in the cache, its value is replaced and its access count incremented instead.
+// If the cache is full, the least frequently used element is evicted first.
+func (cache *Cache[T, P]) Put(key T, value P) {
+	if n, found := cache.table[key]; found {
+		n.value = value
+		cache.touch(n)
+		return
+	}
+	if len(cache.table) >= cache.capacity {
+		cache.evict()
+	}
+	n := &node[T, P]{key: key, value: value, count: 1}
+	cache.table[key] = n
+	cache.link(n)
+	cache.minCount = 1
+}
+
+// Remove removes the element from the cache by key.
+// The onEvict callback is not called for removed elements.
+func (cache *Cache[T, P]) Remove(key T) {
+	if n, found := cache.table[key]; found {
+		cache.unlink(n)
+		delete(cache.table, key)
+	}
+}
+
+// Count returns the access count of the element with the given key, or 0 if key is not found in cache.
+// Does not count as an access itself.
+func (cache *Cache[T, P]) Count(key T) int {
+	if n, found := cache.table[key]; found {
+		return n.count
+	}
+	return 0
+}
+
+// Len returns number of elements in the cache.
+func (cache *Cache[T, P]) Len() int {
+	return len(cache.table)
+}
+
+// Cap returns the maximum number of elements in the cache.
+func (cache *Cache[T, P]) Cap() int {
+	return cache.capacity
+}
+
+// Keys returns all keys ordered from the most to the least frequently used,
+// and from the most to the least recently used among keys with the same access count.
+func (cache *Cache[T, P]) Keys() []T {
+	keys := make([]T, 0, len(cache.table))
+	for _, count := range cache.counts() {
+		for n := cache.buckets[count].head; n != nil; n = n.next {
+			keys = append(keys, n.key)
+		}
+	}
+	return keys
+}
+
+// Clear removes all elements from the cache.
+func (cache *Cache[T, P]) Clear() {
+	cache.table = make(map[T]*node[T, P])
+	cache.buckets = make(map[int]*bucket[T, P])
+	cache.minCount = 0
+}
+
+// String returns a string representation of container
+func (cache *Cache[T, P]) String() string {
+	str := "LFUCache\nmap["
+	for _, count := range cache.counts() {
+		for n := cache.buckets[count].head; n != nil; n = n.next {
+			str += fmt.Sprintf("%v:%v ", n.key, n.value)
+		}
+	}
+	return strings.TrimRight(str, " ") + "]"
+}
+
+// counts returns the access counts of all non-empty buckets in descending order.
+func (cache *Cache[T, P]) counts() []int {
+	counts := make([]int, 0, len(cache.buckets))
+	for count := range cache.buckets {
+		counts = append(counts, count)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(counts)))
+	return counts
+}
+
+func (cache *Cache[T, P]) evict() {
+	b, found := cache.buckets[cache.minCount]
+	if !found {
+		// the minimum bucket was emptied by Remove, find the new minimum
+		cache.minCount = 0
+		for count := range cache.buckets {
+			if cache.minCount == 0 || count < cache.minCount {
+				cache.minCount = count
+			}
+		}
+		b = cache.buckets[cache.minCount]
+	}
+	evicted := b.tail
+	cache.unlink(evicted)
+	delete(cache.table, evicted.key)
+	if cache.onEvict != nil {
+		cache.onEvict(evicted.key, evicted.value)
+	}
+}
+
+func (cache *Cache[T, P]) touch(n *node[T, P]) {
+	cache.unlink(n)
+	if n.count == cache.minCount && cache.buckets[n.count] == nil {
+		cache.minCount++
+	}
+	n.count++
+	cache.link(n)
+}
+
+func (cache *Cache[T, P]) link(n *node[T, P]) {
+	b, found := cache.buckets[n.count]
+	if !found {
+		b = &bucket[T, P]{}
+		cache.buckets[n.count] = b
+	}
+	n.prev = nil
+	n.next = b.head
+	if b.head != nil {
+		b.head.prev = n
+	}
+	b.head = n
+	if b.tail == nil {
+		b.tail = n
+	}
+}
+
+func (cache *Cache[T, P]) unlink(n *node[T, P]) {
+	b := cache.buckets[n.count]
+	if n.prev != nil {
+		n.prev.next = n.next
+	} else {
+		b.head = n.next
+	}
+	if n.next != nil {
+		n.next.prev = n.prev
+	} else {
+		b.tail = n.prev
+	}
+	n.prev = nil
+	n.next = nil
+	if b.head == nil {
+		delete(cache.buckets, n.count)
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lfu
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestCachePutGet(t *testing.T) {
+	cache := New[string, int](2)
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Put("a", 10) // overwrite
+
+	if actualValue := cache.Len(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, found := cache.Get("a"); actualValue != 10 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 10)
+	}
+	if actualValue, found := cache.Get("c"); actualValue != 0 || found {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue := cache.Count("a"); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue := cache.Count("c"); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", cache.Keys()), "[a b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestCacheEviction(t *testing.T) {
+	evicted := []string{}
+	cache := NewWithEvict[string, int](3, func(key string, value int) {
+		evicted = append(evicted, fmt.Sprintf("%v:%v", key, value))
+	})
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Put("c", 3)
+	cache.Get("a")
+	cache.Get("a")
+	cache.Get("b")
+	cache.Put("d", 4) // evicts c, the only one used once
+	cache.Put("e", 5) // evicts d
+	cache.Get("e")
+	cache.Put("f", 6) // b and e are used twice, evicts b as it was used least recently
+
+	if actualValue, expectedValue := fmt.Sprintf("%v", evicted), "[c:3 d:4 b:2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", cache.Keys()), "[a e f]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := cache.Len(); actualValue != cache.Cap() {
+		t.Errorf("Got %v expected %v", actualValue, cache.Cap())
+	}
+}
+
+func TestCacheRemove(t *testing.T) {
+	cache := New[int, int](3)
+	cache.Put(1, 1)
+	cache.Put(2, 2)
+	cache.Put(3, 3)
+	cache.Get(1)
+	cache.Get(3)
+
+	cache.Remove(2) // empties the minimum bucket
+	cache.Remove(4)
+	if actualValue, expectedValue := fmt.Sprintf("%v", cache.Keys()), "[3 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	cache.Get(3)
+	cache.Put(4, 4)
+	cache.Put(5, 5) // evicts 4
+	cache.Put(6, 6) // evicts 5
+	if actualValue, expectedValue := fmt.Sprintf("%v", cache.Keys()), "[3 1 6]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	cache.Remove(6)
+	cache.Put(7, 7)
+	cache.Put(8, 8) // evicts 7
+	if actualValue, expectedValue := fmt.Sprintf("%v", cache.Keys()), "[3 1 8]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	cache.Clear()
+	if _, found := cache.Get(3); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+	if actualValue := cache.Len(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func TestCacheInvalidCapacity(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected panic for non-positive capacity")
+		}
+	}()
+	New[int, int](0)
+}
+
+func TestCacheString(t *testing.T) {
+	cache := New[string, int](2)
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Get("a")
+	if actualValue, expectedValue := cache.String(), "LFUCache\nmap[a:1 b:2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
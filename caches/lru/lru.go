@@ -0,0 +1,166 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package lru implements a fixed capacity cache that evicts the least recently used element.
+//
+// It is backed by a hash table to find elements and a doubly-linked list to store recency ordering,
+// where the hash table holds the list nodes directly, so that Get, Put and Remove run in O(1).
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Cache_replacement_policies#Least_recently_used_(LRU)
+package lru
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Cache holds the elements in a hash table of nodes linked in order of use, most recently used first.
+type Cache[T comparable, P any] struct {
+	table    map[T]*node[T, P]
+	head     *node[T, P] // most recently used
+	tail     *node[T, P] // least recently used
+	capacity int
+	onEvict  func(key T, value P)
+}
+
+type node[T comparable, P any] struct {
+	key   T
+	value P
+	prev  *node[T, P]
+	next  *node[T, P]
+}
+
+// New instantiates a LRU cache holding at most capacity elements.
+// Panics if capacity is not positive.
+func New[T comparable, P any](capacity int) *Cache[T, P] {
+	return NewWithEvict[T, P](capacity, nil)
+}
+
+// NewWithEvict instantiates a LRU cache holding at most capacity elements,
+// which calls onEvict with every element evicted to make room for a new one.
+// Panics if capacity is not positive.
+func NewWithEvict[T comparable, P any](capacity int, onEvict func(key T, value P)) *Cache[T, P] {
+	if capacity <= 0 {
+		panic("capacity must be positive")
+	}
+	return &Cache[T, P]{
+		table:    make(map[T]*node[T, P]),
+		capacity: capacity,
+		onEvict:  onEvict,
+	}
+}
+
+// Get searches the element in the cache by key and returns its value or nil if key is not found in cache.
+// Second return parameter is true if key was found, otherwise false.
+// A found element is marked as the most recently used.
+func (cache *Cache[T, P]) Get(key T) (value P, found bool) {
+	n, found := cache.table[key]
+	if !found {
+		return value, false
+	}
+	cache.moveToFront(n)
+	return n.value, true
+}
+
+// Put inserts key-value pair into the cache and marks it as the most recently used.
+// If the cache is full, the least recently used element is evicted first.
+func (cache *Cache[T, P]) Put(key T, value P) {
+	if n, found := cache.table[key]; found {
+		n.value = value
+		cache.moveToFront(n)
+		return
+	}
+	if len(cache.table) >= cache.capacity {
+		evicted := cache.tail
+		cache.unlink(evicted)
+		delete(cache.table, evicted.key)
+		if cache.onEvict != nil {
+			cache.onEvict(evicted.key, evicted.value)
+		}
+	}
+	n := &node[T, P]{key: key, value: value}
+	cache.table[key] = n
+	cache.pushFront(n)
+}
+
+// Remove removes the element from the cache by key.
+// The onEvict callback is not called for removed elements.
+func (cache *Cache[T, P]) Remove(key T) {
+	if n, found := cache.table[key]; found {
+		cache.unlink(n)
+		delete(cache.table, key)
+	}
+}
+
+// Len returns number of elements in the cache.
+func (cache *Cache[T, P]) Len() int {
+	return len(cache.table)
+}
+
+// Cap returns the maximum number of elements in the cache.
+func (cache *Cache[T, P]) Cap() int {
+	return cache.capacity
+}
+
+// Keys returns all keys ordered from the most to the least recently used.
+func (cache *Cache[T, P]) Keys() []T {
+	keys := make([]T, 0, len(cache.table))
+	for n := cache.head; n != nil; n = n.next {
+		keys = append(keys, n.key)
+	}
+	return keys
+}
+
+// Clear removes all elements from the cache.
+func (cache *Cache[T, P]) Clear() {
+	cache.table = make(map[T]*node[T, P])
+	cache.head = nil
+	cache.tail = nil
+}
+
+// String returns a string representation of container
+func (cache *Cache[T, P]) String() string {
+	str := "LRUCache\nmap["
+	for n := cache.head; n != nil; n = n.next {
+		str += fmt.Sprintf("%v:%v ", n.key, n.value)
+	}
+	return strings.TrimRight(str, " ") + "]"
+}
+
+func (cache *Cache[T, P]) moveToFront(n *node[T, P]) {
+	if cache.head == n {
+		return
+	}
+	cache.unlink(n)
+	cache.pushFront(n)
+}
+
+func (cache *Cache[T, P]) pushFront(n *node[T, P]) {
+	n.prev = nil
+	n.next = cache.head
+	if cache.head != nil {
+		cache.head.prev = n
+	}
+	cache.head = n
+	if cache.tail == nil {
+		cache.tail = n
+	}
+}
+
+func (cache *Cache[T, P]) unlink(n *node[T, P]) {
+	if n.prev != nil {
+		n.prev.next = n.next
+	} else {
+		cache.head = n.next
+	}
+	if n.next != nil {
+		n.next.prev = n.prev
+	} else {
+		cache.tail = n.prev
+	}
+	n.prev = nil
+	n.next = nil
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lru
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestCachePutGet(t *testing.T) {
+	cache := New[string, int](2)
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Put("a", 10) // overwrite
+
+	if actualValue := cache.Len(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, found := cache.Get("a"); actualValue != 10 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 10)
+	}
+	if actualValue, found := cache.Get("c"); actualValue != 0 || found {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", cache.Keys()), "[a b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestCacheEviction(t *testing.T) {
+	evicted := []string{}
+	cache := NewWithEvict[string, int](3, func(key string, value int) {
+		evicted = append(evicted, fmt.Sprintf("%v:%v", key, value))
+	})
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Put("c", 3)
+	cache.Get("a")    // b is now least recently used
+	cache.Put("d", 4) // evicts b
+	cache.Put("c", 5) // touches c
+	cache.Put("e", 6) // evicts a
+
+	if actualValue, expectedValue := fmt.Sprintf("%v", evicted), "[b:2 a:1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", cache.Keys()), "[e c d]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := cache.Len(); actualValue != cache.Cap() {
+		t.Errorf("Got %v expected %v", actualValue, cache.Cap())
+	}
+}
+
+func TestCacheRemove(t *testing.T) {
+	cache := New[int, int](3)
+	cache.Put(1, 1)
+	cache.Put(2, 2)
+	cache.Put(3, 3)
+
+	cache.Remove(2)
+	cache.Remove(4)
+	if actualValue, expectedValue := fmt.Sprintf("%v", cache.Keys()), "[3 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	cache.Remove(3)
+	cache.Remove(1)
+	if actualValue := cache.Len(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	cache.Put(5, 5)
+	if actualValue, expectedValue := fmt.Sprintf("%v", cache.Keys()), "[5]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	cache.Clear()
+	if _, found := cache.Get(5); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+}
+
+func TestCacheInvalidCapacity(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected panic for non-positive capacity")
+		}
+	}()
+	New[int, int](0)
+}
+
+func TestCacheString(t *testing.T) {
+	cache := New[string, int](2)
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	if actualValue, expectedValue := cache.String(), "LRUCache\nmap[b:2 a:1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
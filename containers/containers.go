@@ -13,7 +13,12 @@
 // Serialization provides serializers (marshalers) and deserializers (unmarshalers).
 package containers
 
-import "github.com/lemonyxk/gods/utils"
+import (
+	"container/heap"
+	"math/rand"
+
+	"github.com/lemonyxk/gods/utils"
+)
 
 // Container is base interface that all data structures implement.
 type Container[P any] interface {
@@ -23,6 +28,12 @@ type Container[P any] interface {
 	Values() []P
 }
 
+// Entry is a key-value pair as held by containers whose elements are key value pairs.
+type Entry[T comparable, P any] struct {
+	Key   T
+	Value P
+}
+
 // GetSortedValues returns sorted container's elements with respect to the passed comparator.
 // Does not effect the ordering of elements within the container.
 func GetSortedValues[P any](container Container[P], comparator utils.Comparator) []P {
@@ -33,3 +44,144 @@ func GetSortedValues[P any](container Container[P], comparator utils.Comparator)
 	utils.Sort[P](values, comparator)
 	return values
 }
+
+// Contains returns true if the container holds the given value, compared with ==.
+// Containers with a faster membership test, e.g. sets, should be queried with their own Contains method instead.
+func Contains[P comparable](container Container[P], value P) bool {
+	for _, v := range container.Values() {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Equal returns true if both containers hold the same elements in the same order, as returned by their Values().
+func Equal[P comparable](a, b Container[P]) bool {
+	if a.Size() != b.Size() {
+		return false
+	}
+	valuesA, valuesB := a.Values(), b.Values()
+	for i := range valuesA {
+		if valuesA[i] != valuesB[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualUnordered returns true if both containers hold the same elements equally often, regardless of order.
+func EqualUnordered[P comparable](a, b Container[P]) bool {
+	if a.Size() != b.Size() {
+		return false
+	}
+	counts := make(map[P]int)
+	for _, value := range a.Values() {
+		counts[value]++
+	}
+	for _, value := range b.Values() {
+		if counts[value] == 0 {
+			return false
+		}
+		counts[value]--
+	}
+	return true
+}
+
+// Tee calls both f and g with each element of the container, in the order returned by Values(),
+// e.g. to feed two independent aggregations in a single pass over the container.
+// For each element f is called before g.
+func Tee[P any](container Container[P], f, g func(value P)) {
+	for _, value := range container.Values() {
+		f(value)
+		g(value)
+	}
+}
+
+// ReservoirSample returns a uniformly random selection of k elements of the container using the given random source.
+// Elements are sampled in a single pass over the container's values with reservoir sampling (Vitter's algorithm R).
+// Returns all elements if the container holds k elements or fewer, and an empty slice if k is not positive.
+// Does not effect the ordering of elements within the container.
+func ReservoirSample[P any](container Container[P], k int, r *rand.Rand) []P {
+	if k <= 0 {
+		return []P{}
+	}
+	values := container.Values()
+	if len(values) <= k {
+		return append([]P(nil), values...)
+	}
+	sample := append([]P(nil), values[:k]...)
+	for i := k; i < len(values); i++ {
+		if j := r.Intn(i + 1); j < k {
+			sample[j] = values[i]
+		}
+	}
+	return sample
+}
+
+// ValueCount is an element together with its number of occurrences, as returned by MostCommon.
+type ValueCount[P comparable] struct {
+	Value P
+	Count int
+}
+
+// MostCommon returns the n most common elements of the container and their number of occurrences,
+// ordered from the most to the least common. Elements occurring equally often are ordered by their first occurrence.
+// Returns all distinct elements if the container holds n distinct elements or fewer, and an empty slice if n is not positive.
+//
+// The top elements are selected with a binary heap bounded to n elements, i.e. in O(m log n) for m distinct elements.
+func MostCommon[P comparable](container Container[P], n int) []ValueCount[P] {
+	if n <= 0 {
+		return []ValueCount[P]{}
+	}
+	counts := make(map[P]int)
+	var order []P
+	for _, value := range container.Values() {
+		if counts[value] == 0 {
+			order = append(order, value)
+		}
+		counts[value]++
+	}
+	h := &leastCommonHeap[P]{}
+	for index, value := range order {
+		item := mostCommonItem[P]{ValueCount[P]{value, counts[value]}, index}
+		if h.Len() < n {
+			heap.Push(h, item)
+		} else if h.less((*h)[0], item) {
+			(*h)[0] = item
+			heap.Fix(h, 0)
+		}
+	}
+	result := make([]ValueCount[P], h.Len())
+	for i := len(result) - 1; i >= 0; i-- {
+		result[i] = heap.Pop(h).(mostCommonItem[P]).ValueCount
+	}
+	return result
+}
+
+// mostCommonItem is a counted element remembering the index of its first occurrence for tie-breaking.
+type mostCommonItem[P comparable] struct {
+	ValueCount[P]
+	index int
+}
+
+// leastCommonHeap is a min-heap keeping the least common item, or the latest among equally common items, on top.
+type leastCommonHeap[P comparable] []mostCommonItem[P]
+
+func (h leastCommonHeap[P]) less(a, b mostCommonItem[P]) bool {
+	if a.Count != b.Count {
+		return a.Count < b.Count
+	}
+	return a.index > b.index
+}
+
+func (h leastCommonHeap[P]) Len() int           { return len(h) }
+func (h leastCommonHeap[P]) Less(i, j int) bool { return h.less(h[i], h[j]) }
+func (h leastCommonHeap[P]) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *leastCommonHeap[P]) Push(x any)        { *h = append(*h, x.(mostCommonItem[P])) }
+func (h *leastCommonHeap[P]) Pop() any {
+	old := *h
+	item := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return item
+}
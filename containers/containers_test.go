@@ -7,6 +7,8 @@
 package containers
 
 import (
+	"fmt"
+	"math/rand"
 	"testing"
 
 	"github.com/emirpasic/gods/utils"
@@ -54,3 +56,120 @@ func TestGetSortedValuesStrings(t *testing.T) {
 		}
 	}
 }
+
+func TestContains(t *testing.T) {
+	container := ContainerTest[string]{}
+	container.values = []string{"a", "b", "c"}
+	if actualValue := Contains[string](container, "b"); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := Contains[string](container, "x"); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := Contains[string](ContainerTest[string]{}, "a"); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestEqual(t *testing.T) {
+	container := ContainerTest[int]{}
+	container.values = []int{1, 2, 2, 3}
+	// other,expectedEqual,expectedEqualUnordered
+	tests := [][]interface{}{
+		{[]int{1, 2, 2, 3}, true, true},
+		{[]int{2, 3, 1, 2}, false, true},
+		{[]int{1, 2, 3, 3}, false, false},
+		{[]int{1, 2, 3}, false, false},
+		{[]int{}, false, false},
+	}
+	for _, test := range tests {
+		other := ContainerTest[int]{}
+		other.values = test[0].([]int)
+		if actualValue := Equal[int](container, other); actualValue != test[1] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+		if actualValue := EqualUnordered[int](container, other); actualValue != test[2] {
+			t.Errorf("Got %v expected %v", actualValue, test[2])
+		}
+	}
+	if actualValue := Equal[int](ContainerTest[int]{}, ContainerTest[int]{}); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestTee(t *testing.T) {
+	container := ContainerTest[int]{}
+	container.values = []int{1, 2, 3}
+	sum, count, order := 0, 0, []int{}
+	Tee[int](container, func(value int) {
+		sum += value
+		order = append(order, value)
+	}, func(value int) {
+		count++
+		order = append(order, -value)
+	})
+	if actualValue := sum; actualValue != 6 {
+		t.Errorf("Got %v expected %v", actualValue, 6)
+	}
+	if actualValue := count; actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, expectedValue := fmt.Sprint(order), "[1 -1 2 -2 3 -3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMostCommon(t *testing.T) {
+	container := ContainerTest[string]{}
+	container.values = []string{"b", "a", "c", "a", "b", "d", "a", "c", "b"}
+	if actualValue, expectedValue := fmt.Sprint(MostCommon[string](container, 2)), "[{b 3} {a 3}]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(MostCommon[string](container, 3)), "[{b 3} {a 3} {c 2}]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(MostCommon[string](container, 10)), "[{b 3} {a 3} {c 2} {d 1}]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := len(MostCommon[string](container, 0)); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue := len(MostCommon[string](ContainerTest[string]{}, 3)); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func TestReservoirSample(t *testing.T) {
+	container := ContainerTest[int]{}
+	container.values = []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	r := rand.New(rand.NewSource(1))
+
+	if actualValue := len(ReservoirSample[int](container, 0, r)); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue := len(ReservoirSample[int](container, 20, r)); actualValue != 10 {
+		t.Errorf("Got %v expected %v", actualValue, 10)
+	}
+
+	counts := make(map[int]int)
+	for i := 0; i < 10000; i++ {
+		sample := ReservoirSample[int](container, 3, r)
+		if actualValue := len(sample); actualValue != 3 {
+			t.Errorf("Got %v expected %v", actualValue, 3)
+		}
+		seen := make(map[int]bool)
+		for _, value := range sample {
+			if seen[value] {
+				t.Errorf("Got duplicate value %v", value)
+			}
+			seen[value] = true
+			counts[value]++
+		}
+	}
+	// each element is expected to be picked 3000 times
+	for value, count := range counts {
+		if count < 2700 || count > 3300 {
+			t.Errorf("Got %v picks of %v expected about %v", count, value, 3000)
+		}
+	}
+}
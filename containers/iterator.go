@@ -46,10 +46,23 @@ type IteratorWithKey[T comparable, P any] interface {
 	// Does not modify the state of the iterator.
 	Key() T
 
+	// KeyValue returns the current element's key and value as an entry.
+	// Does not modify the state of the iterator.
+	KeyValue() Entry[T, P]
+
 	// Begin resets the iterator to its initial state (one-before-first)
 	// Call Next() to fetch the first element if any.
 	Begin()
 
+	// Reset resets the iterator to its initial state (one-before-first), same as Begin().
+	// Call Next() to fetch the first element if any.
+	Reset()
+
+	// Done returns true if the iterator is past the last element (one-past-the-end),
+	// e.g. after Next() returned false.
+	// Does not modify the state of the iterator.
+	Done() bool
+
 	// First moves the iterator to the first element and returns true if there was a first element in the container.
 	// If First() returns true, then first element's key and value can be retrieved by Key() and Value().
 	// Modifies the state of the iterator.
@@ -1,5 +1,5 @@
 module github.com/lemonyxk/gods
 
-go 1.18
+go 1.23
 
 require github.com/emirpasic/gods v1.12.0
@@ -25,6 +25,7 @@ func assertListImplementation[T comparable]() {
 type List[T comparable] struct {
 	elements []T
 	size     int
+	sealed   bool
 }
 
 const (
@@ -43,6 +44,7 @@ func New[T comparable](values ...T) *List[T] {
 
 // Add appends a value at the end of the list
 func (list *List[T]) Add(values ...T) {
+	list.checkSealed()
 	list.growBy(len(values))
 	for _, value := range values {
 		list.elements[list.size] = value
@@ -51,9 +53,14 @@ func (list *List[T]) Add(values ...T) {
 }
 
 // Get returns the element at index.
+// Negative index counts from the end of the list, i.e. -1 is the last element.
 // Second return parameter is true if index is within bounds of the array and array is not empty, otherwise false.
 func (list *List[T]) Get(index int) (T, bool) {
 
+	if index < 0 {
+		index += list.size
+	}
+
 	if !list.withinRange(index) {
 		var t T
 		return t, false
@@ -63,7 +70,14 @@ func (list *List[T]) Get(index int) (T, bool) {
 }
 
 // Remove removes the element at the given index from the list.
+// Negative index counts from the end of the list, i.e. -1 is the last element.
+// Does not do anything if index is out of bounds.
 func (list *List[T]) Remove(index int) {
+	list.checkSealed()
+
+	if index < 0 {
+		index += list.size
+	}
 
 	if !list.withinRange(index) {
 		return
@@ -130,12 +144,14 @@ func (list *List[T]) Size() int {
 
 // Clear removes all elements from the list.
 func (list *List[T]) Clear() {
+	list.checkSealed()
 	list.size = 0
 	list.elements = []T{}
 }
 
 // Sort sorts values (in-place) using.
 func (list *List[T]) Sort(comparator utils.Comparator) {
+	list.checkSealed()
 	if len(list.elements) < 2 {
 		return
 	}
@@ -144,6 +160,7 @@ func (list *List[T]) Sort(comparator utils.Comparator) {
 
 // Swap swaps the two values at the specified positions.
 func (list *List[T]) Swap(i, j int) {
+	list.checkSealed()
 	if list.withinRange(i) && list.withinRange(j) {
 		list.elements[i], list.elements[j] = list.elements[j], list.elements[i]
 	}
@@ -153,6 +170,7 @@ func (list *List[T]) Swap(i, j int) {
 // Does not do anything if position is negative or bigger than list's size
 // Note: position equal to list's size is valid, i.e. append.
 func (list *List[T]) Insert(index int, values ...T) {
+	list.checkSealed()
 
 	if !list.withinRange(index) {
 		// Append
@@ -173,6 +191,7 @@ func (list *List[T]) Insert(index int, values ...T) {
 // Does not do anything if position is negative or bigger than list's size
 // Note: position equal to list's size is valid, i.e. append.
 func (list *List[T]) Set(index int, value T) {
+	list.checkSealed()
 
 	if !list.withinRange(index) {
 		// Append
@@ -185,6 +204,17 @@ func (list *List[T]) Set(index int, value T) {
 	list.elements[index] = value
 }
 
+// Seal makes the list immutable; later modifying calls panic with "container is frozen".
+// Reads remain allowed. Sealing is one-way.
+func (list *List[T]) Seal() {
+	list.sealed = true
+}
+
+// Sealed returns true if the list has been sealed.
+func (list *List[T]) Sealed() bool {
+	return list.sealed
+}
+
 // String returns a string representation of container
 func (list *List[T]) String() string {
 	str := "ArrayList\n"
@@ -228,3 +258,10 @@ func (list *List[T]) shrink() {
 		list.resize(list.size)
 	}
 }
+
+// checkSealed panics if the list has been sealed.
+func (list *List[T]) checkSealed() {
+	if list.sealed {
+		panic("container is frozen")
+	}
+}
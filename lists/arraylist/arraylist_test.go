@@ -145,6 +145,31 @@ func TestListSort(t *testing.T) {
 	}
 }
 
+func TestListSeal(t *testing.T) {
+	list := New[int](1, 2, 3)
+	list.Seal()
+	if actualValue := list.Sealed(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	assertFrozenPanic(t, func() { list.Add(4) })
+	assertFrozenPanic(t, func() { list.Remove(0) })
+	assertFrozenPanic(t, func() { list.Set(0, 5) })
+	assertFrozenPanic(t, func() { list.Sort(utils.IntComparator) })
+	if actualValue, expectedValue := fmt.Sprint(list.Values()), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+// assertFrozenPanic asserts that f panics because the container is frozen.
+func assertFrozenPanic(t *testing.T, f func()) {
+	defer func() {
+		if r := recover(); r != "container is frozen" {
+			t.Errorf("Got %v expected %v", r, "container is frozen")
+		}
+	}()
+	f()
+}
+
 func TestListClear(t *testing.T) {
 	list := New[string]()
 	list.Add("e", "f", "g", "a", "b", "c", "d")
@@ -157,6 +182,35 @@ func TestListClear(t *testing.T) {
 	}
 }
 
+func TestListNegativeIndex(t *testing.T) {
+	list := New[string]()
+	list.Add("a", "b", "c")
+
+	// index,expectedValue,expectedOk
+	tests := [][]interface{}{
+		{-1, "c", true},
+		{-3, "a", true},
+		{-4, "", false},
+		{3, "", false},
+	}
+	for _, test := range tests {
+		if actualValue, ok := list.Get(test[0].(int)); actualValue != test[1] || ok != test[2] {
+			t.Errorf("Got %v,%v expected %v,%v", actualValue, ok, test[1], test[2])
+		}
+	}
+
+	list.Remove(-4)
+	list.Remove(3)
+	list.Remove(-1)
+	if actualValue, expectedValue := fmt.Sprintf("%v", list.Values()), "[a b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.Remove(-2)
+	if actualValue, expectedValue := fmt.Sprintf("%v", list.Values()), "[b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func TestListContains(t *testing.T) {
 	list := New[string]()
 	list.Add("a")
@@ -334,6 +388,24 @@ func TestListFind(t *testing.T) {
 		t.Errorf("Got %v at %v expected %v at %v", foundValue, foundIndex, nil, nil)
 	}
 }
+
+func TestListMinMaxBy(t *testing.T) {
+	list := New[string]()
+	score := func(index int, value string) float64 {
+		return float64(len(value))
+	}
+	if _, _, ok := list.MinBy(score); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	list.Add("ccc", "a", "bb", "d", "eee")
+	if index, value, ok := list.MinBy(score); index != 1 || value != "a" || !ok {
+		t.Errorf("Got %v at %v expected %v at %v", value, index, "a", 1)
+	}
+	if index, value, ok := list.MaxBy(score); index != 0 || value != "ccc" || !ok {
+		t.Errorf("Got %v at %v expected %v at %v", value, index, "ccc", 0)
+	}
+}
+
 func TestListChaining(t *testing.T) {
 	list := New[string]()
 	list.Add("a", "b", "c")
@@ -502,6 +574,19 @@ func TestListIteratorLast(t *testing.T) {
 	}
 }
 
+func TestListFromJSONInvalid(t *testing.T) {
+	list := New[int]()
+	list.Add(1, 2)
+	for _, data := range []string{`[3,"x",5]`, `[3,`, `{"a":1}`} {
+		if err := list.FromJSON([]byte(data)); err == nil {
+			t.Errorf("Expected error for %v", data)
+		}
+		if actualValue, expectedValue := fmt.Sprintf("%v", list.Values()), "[1 2]"; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
 func TestListSerialization(t *testing.T) {
 	list := New[string]()
 	list.Add("a", "b", "c")
@@ -21,10 +21,14 @@ func (list *List[T]) ToJSON() ([]byte, error) {
 }
 
 // FromJSON populates list's elements from the input JSON representation.
+// The list is left untouched if the input can not be decoded.
 func (list *List[T]) FromJSON(data []byte) error {
-	err := json.Unmarshal(data, &list.elements)
+	list.checkSealed()
+	elements := []T{}
+	err := json.Unmarshal(data, &elements)
 	if err == nil {
-		list.size = len(list.elements)
+		list.elements = elements
+		list.size = len(elements)
 	}
 	return err
 }
@@ -23,9 +23,10 @@ func assertListImplementation[T comparable]() {
 
 // List holds the elements, where each element points to the next and previous element
 type List[T comparable] struct {
-	first *element[T]
-	last  *element[T]
-	size  int
+	first  *element[T]
+	last   *element[T]
+	size   int
+	sealed bool
 }
 
 type element[T comparable] struct {
@@ -45,6 +46,7 @@ func New[T comparable](values ...T) *List[T] {
 
 // Add appends a value (one or more) at the end of the list (same as Append())
 func (list *List[T]) Add(values ...T) {
+	list.checkSealed()
 	for _, value := range values {
 		newElement := &element[T]{value: value, prev: list.last}
 		if list.size == 0 {
@@ -60,11 +62,13 @@ func (list *List[T]) Add(values ...T) {
 
 // Append appends a value (one or more) at the end of the list (same as Add())
 func (list *List[T]) Append(values ...T) {
+	list.checkSealed()
 	list.Add(values...)
 }
 
 // Prepend prepends a values (or more)
 func (list *List[T]) Prepend(values ...T) {
+	list.checkSealed()
 	// in reverse to keep passed order i.e. ["c","d"] -> Prepend(["a","b"]) -> ["a","b","c",d"]
 	for v := len(values) - 1; v >= 0; v-- {
 		newElement := &element[T]{value: values[v], next: list.first}
@@ -80,9 +84,14 @@ func (list *List[T]) Prepend(values ...T) {
 }
 
 // Get returns the element at index.
+// Negative index counts from the end of the list, i.e. -1 is the last element.
 // Second return parameter is true if index is within bounds of the array and array is not empty, otherwise false.
 func (list *List[T]) Get(index int) (T, bool) {
 
+	if index < 0 {
+		index += list.size
+	}
+
 	if !list.withinRange(index) {
 		return utils.AnyEmpty[T](), false
 	}
@@ -101,7 +110,14 @@ func (list *List[T]) Get(index int) (T, bool) {
 }
 
 // Remove removes the element at the given index from the list.
+// Negative index counts from the end of the list, i.e. -1 is the last element.
+// Does not do anything if index is out of bounds.
 func (list *List[T]) Remove(index int) {
+	list.checkSealed()
+
+	if index < 0 {
+		index += list.size
+	}
 
 	if !list.withinRange(index) {
 		return
@@ -142,6 +158,38 @@ func (list *List[T]) Remove(index int) {
 	list.size--
 }
 
+// RemoveRange removes the elements at indices [from, to) from the list and returns the number of removed elements.
+// The run is unlinked by relinking its boundary elements once, i.e. O(n) to find the boundaries instead of removing one by one.
+// Bounds are clamped to the list, does not do anything if from >= to.
+func (list *List[T]) RemoveRange(from, to int) int {
+	list.checkSealed()
+	from, to = max(from, 0), min(to, list.size)
+	if from >= to {
+		return 0
+	}
+
+	first := list.first
+	for e := 0; e != from; e, first = e+1, first.next {
+	}
+	last := first
+	for e := from; e != to-1; e, last = e+1, last.next {
+	}
+
+	if first.prev == nil {
+		list.first = last.next
+	} else {
+		first.prev.next = last.next
+	}
+	if last.next == nil {
+		list.last = first.prev
+	} else {
+		last.next.prev = first.prev
+	}
+
+	list.size -= to - from
+	return to - from
+}
+
 // Contains check if values (one or more) are present in the set.
 // All values have to be present in the set for the method to return true.
 // Performance time complexity of n^2.
@@ -169,6 +217,19 @@ func (list *List[T]) Contains(values ...T) bool {
 	return true
 }
 
+// Equal returns true if the other list holds the same elements in the same order, compared with ==.
+func (list *List[T]) Equal(other *List[T]) bool {
+	if list.size != other.size {
+		return false
+	}
+	for a, b := list.first, other.first; a != nil; a, b = a.next, b.next {
+		if a.value != b.value {
+			return false
+		}
+	}
+	return true
+}
+
 // Values returns all elements in the list.
 func (list *List[T]) Values() []T {
 	values := make([]T, list.size, list.size)
@@ -203,6 +264,7 @@ func (list *List[T]) Size() int {
 
 // Clear removes all elements from the list.
 func (list *List[T]) Clear() {
+	list.checkSealed()
 	list.size = 0
 	list.first = nil
 	list.last = nil
@@ -210,6 +272,7 @@ func (list *List[T]) Clear() {
 
 // Sort sorts values (in-place) using.
 func (list *List[T]) Sort(comparator utils.Comparator) {
+	list.checkSealed()
 
 	if list.size < 2 {
 		return
@@ -224,8 +287,56 @@ func (list *List[T]) Sort(comparator utils.Comparator) {
 
 }
 
+// MergeSorted merges the other list into this list in O(n+m), by relinking the elements of both lists.
+// Both lists must already be sorted with respect to the comparator, otherwise the result is not sorted.
+// Equal values of this list are placed before those of the other list. The other list is left empty.
+func (list *List[T]) MergeSorted(other *List[T], comparator utils.Comparator) {
+	list.checkSealed()
+	other.checkSealed()
+	if other == list || other.size == 0 {
+		return
+	}
+	a, b := list.first, other.first
+	var first, last *element[T]
+	for a != nil || b != nil {
+		var next *element[T]
+		if b == nil || (a != nil && comparator(a.value, b.value) <= 0) {
+			next, a = a, a.next
+		} else {
+			next, b = b, b.next
+		}
+		next.prev = last
+		if last == nil {
+			first = next
+		} else {
+			last.next = next
+		}
+		last = next
+	}
+	last.next = nil
+	list.first, list.last = first, last
+	list.size += other.size
+	other.Clear()
+}
+
+// DistinctBy returns a new list holding the first element of the list for each key computed by keyFn, in the list's order.
+// Useful to deduplicate elements by a logical identity, e.g. a single field of a struct, rather than by equality of whole elements.
+func DistinctBy[T comparable, K comparable](list *List[T], keyFn func(T) K) *List[T] {
+	seen := make(map[K]struct{})
+	distinct := New[T]()
+	for e := list.first; e != nil; e = e.next {
+		key := keyFn(e.value)
+		if _, found := seen[key]; !found {
+			seen[key] = struct{}{}
+			distinct.Append(e.value)
+		}
+	}
+	return distinct
+}
+
 // Swap swaps values of two elements at the given indices.
 func (list *List[T]) Swap(i, j int) {
+	list.checkSealed()
 	if list.withinRange(i) && list.withinRange(j) && i != j {
 		var element1, element2 *element[T]
 		for e, currentElement := 0, list.first; element1 == nil || element2 == nil; e, currentElement = e+1, currentElement.next {
@@ -244,6 +355,7 @@ func (list *List[T]) Swap(i, j int) {
 // Does not do anything if position is negative or bigger than list's size
 // Note: position equal to list's size is valid, i.e. append.
 func (list *List[T]) Insert(index int, values ...T) {
+	list.checkSealed()
 
 	if !list.withinRange(index) {
 		// Append
@@ -301,6 +413,7 @@ func (list *List[T]) Insert(index int, values ...T) {
 // Does not do anything if position is negative or bigger than list's size
 // Note: position equal to list's size is valid, i.e. append.
 func (list *List[T]) Set(index int, value T) {
+	list.checkSealed()
 
 	if !list.withinRange(index) {
 		// Append
@@ -328,6 +441,17 @@ func (list *List[T]) Set(index int, value T) {
 	foundElement.value = value
 }
 
+// Seal makes the list immutable; later modifying calls panic with "container is frozen".
+// Reads remain allowed. Sealing is one-way.
+func (list *List[T]) Seal() {
+	list.sealed = true
+}
+
+// Sealed returns true if the list has been sealed.
+func (list *List[T]) Sealed() bool {
+	return list.sealed
+}
+
 // String returns a string representation of container
 func (list *List[T]) String() string {
 	str := "DoublyLinkedList\n"
@@ -343,3 +467,10 @@ func (list *List[T]) String() string {
 func (list *List[T]) withinRange(index int) bool {
 	return index >= 0 && index < list.size
 }
+
+// checkSealed panics if the list has been sealed.
+func (list *List[T]) checkSealed() {
+	if list.sealed {
+		panic("container is frozen")
+	}
+}
@@ -72,6 +72,39 @@ func TestListRemove(t *testing.T) {
 	}
 }
 
+func TestListRemoveRange(t *testing.T) {
+	// from,to,expectedRemoved,expectedValues
+	tests := [][]interface{}{
+		{1, 3, 2, "[a d e]"},
+		{0, 2, 2, "[c d e]"},
+		{3, 5, 2, "[a b c]"},
+		{-2, 9, 5, "[]"},
+		{2, 2, 0, "[a b c d e]"},
+		{4, 1, 0, "[a b c d e]"},
+		{5, 9, 0, "[a b c d e]"},
+	}
+	for _, test := range tests {
+		list := New[string]("a", "b", "c", "d", "e")
+		if actualValue := list.RemoveRange(test[0].(int), test[1].(int)); actualValue != test[2] {
+			t.Errorf("Got %v expected %v", actualValue, test[2])
+		}
+		if actualValue := fmt.Sprint(list.Values()); actualValue != test[3] {
+			t.Errorf("Got %v expected %v", actualValue, test[3])
+		}
+		if actualValue := list.Size(); actualValue != 5-test[2].(int) {
+			t.Errorf("Got %v expected %v", actualValue, 5-test[2].(int))
+		}
+		list.Append("x")
+		list.Prepend("y")
+		if actualValue, _ := list.Get(list.Size() - 1); actualValue != "x" {
+			t.Errorf("Got %v expected %v", actualValue, "x")
+		}
+		if actualValue, _ := list.Get(0); actualValue != "y" {
+			t.Errorf("Got %v expected %v", actualValue, "y")
+		}
+	}
+}
+
 func TestListGet(t *testing.T) {
 	list := New[string]()
 	list.Add("a")
@@ -118,6 +151,57 @@ func TestListSort(t *testing.T) {
 	}
 }
 
+func TestListMergeSorted(t *testing.T) {
+	list := New[int](1, 3, 5, 7)
+	other := New[int](2, 3, 4, 8, 9)
+	list.MergeSorted(other, utils.IntComparator)
+	if actualValue, expectedValue := fmt.Sprint(list.Values()), "[1 2 3 3 4 5 7 8 9]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := list.Size(); actualValue != 9 {
+		t.Errorf("Got %v expected %v", actualValue, 9)
+	}
+	if actualValue := other.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	list.Add(10)
+	if actualValue, ok := list.Get(-1); actualValue != 10 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 10)
+	}
+	if actualValue, ok := list.Get(8); actualValue != 9 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 9)
+	}
+
+	empty := New[int]()
+	empty.MergeSorted(New[int](1, 2), utils.IntComparator)
+	if actualValue, expectedValue := fmt.Sprint(empty.Values()), "[1 2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	empty.MergeSorted(New[int](), utils.IntComparator)
+	empty.MergeSorted(empty, utils.IntComparator)
+	if actualValue, expectedValue := fmt.Sprint(empty.Values()), "[1 2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListDistinctBy(t *testing.T) {
+	type person struct {
+		name string
+		age  int
+	}
+	list := New(person{"a", 1}, person{"b", 2}, person{"a", 3}, person{"c", 1}, person{"b", 4})
+	distinct := DistinctBy(list, func(p person) string { return p.name })
+	if actualValue, expectedValue := fmt.Sprint(distinct.Values()), "[{a 1} {b 2} {c 1}]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := list.Size(); actualValue != 5 {
+		t.Errorf("Got %v expected %v", actualValue, 5)
+	}
+	if actualValue := DistinctBy(New[int](), func(i int) int { return i }).Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
 func TestListClear(t *testing.T) {
 	list := New[string]()
 	list.Add("e", "f", "g", "a", "b", "c", "d")
@@ -130,6 +214,35 @@ func TestListClear(t *testing.T) {
 	}
 }
 
+func TestListNegativeIndex(t *testing.T) {
+	list := New[string]()
+	list.Add("a", "b", "c")
+
+	// index,expectedValue,expectedOk
+	tests := [][]interface{}{
+		{-1, "c", true},
+		{-3, "a", true},
+		{-4, "", false},
+		{3, "", false},
+	}
+	for _, test := range tests {
+		if actualValue, ok := list.Get(test[0].(int)); actualValue != test[1] || ok != test[2] {
+			t.Errorf("Got %v,%v expected %v,%v", actualValue, ok, test[1], test[2])
+		}
+	}
+
+	list.Remove(-4)
+	list.Remove(3)
+	list.Remove(-1)
+	if actualValue, expectedValue := fmt.Sprintf("%v", list.Values()), "[a b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.Remove(-2)
+	if actualValue, expectedValue := fmt.Sprintf("%v", list.Values()), "[b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func TestListContains(t *testing.T) {
 	list := New[string]()
 	list.Add("a")
@@ -152,6 +265,24 @@ func TestListContains(t *testing.T) {
 	}
 }
 
+func TestListEqual(t *testing.T) {
+	list := New[string]("a", "b", "c")
+	tests := [][]interface{}{
+		{New[string]("a", "b", "c"), true},
+		{New[string]("a", "c", "b"), false},
+		{New[string]("a", "b"), false},
+		{New[string](), false},
+	}
+	for _, test := range tests {
+		if actualValue := list.Equal(test[0].(*List[string])); actualValue != test[1] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+	}
+	if actualValue := New[string]().Equal(New[string]()); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
 func TestListValues(t *testing.T) {
 	list := New[string]()
 	list.Add("a")
@@ -254,6 +385,21 @@ func TestListEach(t *testing.T) {
 	})
 }
 
+func TestListForEachReverse(t *testing.T) {
+	list := New[string]()
+	list.ForEachReverse(func(index int, value string) {
+		t.Errorf("Shouldn't iterate on empty list")
+	})
+	list.Add("a", "b", "c")
+	visited := []string{}
+	list.ForEachReverse(func(index int, value string) {
+		visited = append(visited, fmt.Sprintf("%v:%v", index, value))
+	})
+	if actualValue, expectedValue := fmt.Sprintf("%v", visited), "[2:c 1:b 0:a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func TestListMap(t *testing.T) {
 	list := New[string]()
 	list.Add("a", "b", "c")
@@ -339,6 +485,24 @@ func TestListFind(t *testing.T) {
 		t.Errorf("Got %v at %v expected %v at %v", foundValue, foundIndex, nil, nil)
 	}
 }
+
+func TestListMinMaxBy(t *testing.T) {
+	list := New[string]()
+	score := func(index int, value string) float64 {
+		return float64(len(value))
+	}
+	if _, _, ok := list.MinBy(score); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	list.Add("ccc", "a", "bb", "d", "eee")
+	if index, value, ok := list.MinBy(score); index != 1 || value != "a" || !ok {
+		t.Errorf("Got %v at %v expected %v at %v", value, index, "a", 1)
+	}
+	if index, value, ok := list.MaxBy(score); index != 0 || value != "ccc" || !ok {
+		t.Errorf("Got %v at %v expected %v at %v", value, index, "ccc", 0)
+	}
+}
+
 func TestListChaining(t *testing.T) {
 	list := New[string]()
 	list.Add("a", "b", "c")
@@ -507,6 +671,19 @@ func TestListIteratorLast(t *testing.T) {
 	}
 }
 
+func TestListFromJSONInvalid(t *testing.T) {
+	list := New[int]()
+	list.Add(1, 2)
+	for _, data := range []string{`[3,"x",5]`, `[3,`, `{"a":1}`} {
+		if err := list.FromJSON([]byte(data)); err == nil {
+			t.Errorf("Expected error for %v", data)
+		}
+		if actualValue, expectedValue := fmt.Sprintf("%v", list.Values()), "[1 2]"; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
 func TestListSerialization(t *testing.T) {
 	list := New[string]()
 	list.Add("a", "b", "c")
@@ -557,6 +734,32 @@ func benchmarkRemove(b *testing.B, list *List[int], size int) {
 	}
 }
 
+func TestListSeal(t *testing.T) {
+	list := New[int](1, 2, 3)
+	list.Seal()
+	if actualValue := list.Sealed(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	assertFrozenPanic(t, func() { list.Add(4) })
+	assertFrozenPanic(t, func() { list.Remove(0) })
+	assertFrozenPanic(t, func() { list.Set(0, 5) })
+	assertFrozenPanic(t, func() { list.Sort(utils.IntComparator) })
+	assertFrozenPanic(t, func() { New[int](0).MergeSorted(list, utils.IntComparator) })
+	if actualValue, expectedValue := fmt.Sprint(list.Values()), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+// assertFrozenPanic asserts that f panics because the container is frozen.
+func assertFrozenPanic(t *testing.T, f func()) {
+	defer func() {
+		if r := recover(); r != "container is frozen" {
+			t.Errorf("Got %v expected %v", r, "container is frozen")
+		}
+	}()
+	f()
+}
+
 func BenchmarkDoublyLinkedListGet100(b *testing.B) {
 	b.StopTimer()
 	size := 100
@@ -21,6 +21,14 @@ func (list *List[T]) Each(f func(index int, value T)) {
 	}
 }
 
+// ForEachReverse calls the given function once for each element from the last to the first,
+// passing that element's (forward) index and value.
+func (list *List[T]) ForEachReverse(f func(index int, value T)) {
+	for index, element := list.size-1, list.last; element != nil; index, element = index-1, element.prev {
+		f(index, element.value)
+	}
+}
+
 // Map invokes the given function once for each element and returns a
 // container containing the values returned by the given function.
 func (list *List[T]) Map(f func(index int, value T) T) *List[T] {
@@ -80,3 +88,29 @@ func (list *List[T]) Find(f func(index int, value T) bool) (index int, value T)
 	}
 	return -1, utils.AnyEmpty[T]()
 }
+
+// MinBy passes each element of the container to the given function and returns the
+// (index,value) of the element with the smallest score, the first one in case of ties.
+// Third return parameter is true, unless the container was empty.
+func (list *List[T]) MinBy(score func(index int, value T) float64) (index int, value T, ok bool) {
+	return list.extremeBy(score, func(a, b float64) bool { return a < b })
+}
+
+// MaxBy passes each element of the container to the given function and returns the
+// (index,value) of the element with the largest score, the first one in case of ties.
+// Third return parameter is true, unless the container was empty.
+func (list *List[T]) MaxBy(score func(index int, value T) float64) (index int, value T, ok bool) {
+	return list.extremeBy(score, func(a, b float64) bool { return a > b })
+}
+
+func (list *List[T]) extremeBy(score func(index int, value T) float64, better func(a, b float64) bool) (index int, value T, ok bool) {
+	index = -1
+	var best float64
+	iterator := list.Iterator()
+	for iterator.Next() {
+		if s := score(iterator.Index(), iterator.Value()); !ok || better(s, best) {
+			index, value, best, ok = iterator.Index(), iterator.Value(), s, true
+		}
+	}
+	return index, value, ok
+}
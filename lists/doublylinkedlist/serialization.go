@@ -21,7 +21,9 @@ func (list *List[T]) ToJSON() ([]byte, error) {
 }
 
 // FromJSON populates list's elements from the input JSON representation.
+// The list is left untouched if the input can not be decoded.
 func (list *List[T]) FromJSON(data []byte) error {
+	list.checkSealed()
 	elements := []T{}
 	err := json.Unmarshal(data, &elements)
 	if err == nil {
@@ -80,3 +80,29 @@ func (list *List[T]) Find(f func(index int, value T) bool) (index int, value T)
 	}
 	return -1, utils.AnyEmpty[T]()
 }
+
+// MinBy passes each element of the container to the given function and returns the
+// (index,value) of the element with the smallest score, the first one in case of ties.
+// Third return parameter is true, unless the container was empty.
+func (list *List[T]) MinBy(score func(index int, value T) float64) (index int, value T, ok bool) {
+	return list.extremeBy(score, func(a, b float64) bool { return a < b })
+}
+
+// MaxBy passes each element of the container to the given function and returns the
+// (index,value) of the element with the largest score, the first one in case of ties.
+// Third return parameter is true, unless the container was empty.
+func (list *List[T]) MaxBy(score func(index int, value T) float64) (index int, value T, ok bool) {
+	return list.extremeBy(score, func(a, b float64) bool { return a > b })
+}
+
+func (list *List[T]) extremeBy(score func(index int, value T) float64, better func(a, b float64) bool) (index int, value T, ok bool) {
+	index = -1
+	var best float64
+	iterator := list.Iterator()
+	for iterator.Next() {
+		if s := score(iterator.Index(), iterator.Value()); !ok || better(s, best) {
+			index, value, best, ok = iterator.Index(), iterator.Value(), s, true
+		}
+	}
+	return index, value, ok
+}
@@ -72,6 +72,39 @@ func TestListRemove(t *testing.T) {
 	}
 }
 
+func TestListRemoveRange(t *testing.T) {
+	// from,to,expectedRemoved,expectedValues
+	tests := [][]interface{}{
+		{1, 3, 2, "[a d e]"},
+		{0, 2, 2, "[c d e]"},
+		{3, 5, 2, "[a b c]"},
+		{-2, 9, 5, "[]"},
+		{2, 2, 0, "[a b c d e]"},
+		{4, 1, 0, "[a b c d e]"},
+		{5, 9, 0, "[a b c d e]"},
+	}
+	for _, test := range tests {
+		list := New[string]("a", "b", "c", "d", "e")
+		if actualValue := list.RemoveRange(test[0].(int), test[1].(int)); actualValue != test[2] {
+			t.Errorf("Got %v expected %v", actualValue, test[2])
+		}
+		if actualValue := fmt.Sprint(list.Values()); actualValue != test[3] {
+			t.Errorf("Got %v expected %v", actualValue, test[3])
+		}
+		if actualValue := list.Size(); actualValue != 5-test[2].(int) {
+			t.Errorf("Got %v expected %v", actualValue, 5-test[2].(int))
+		}
+		list.Append("x")
+		list.Prepend("y")
+		if actualValue, _ := list.Get(list.Size() - 1); actualValue != "x" {
+			t.Errorf("Got %v expected %v", actualValue, "x")
+		}
+		if actualValue, _ := list.Get(0); actualValue != "y" {
+			t.Errorf("Got %v expected %v", actualValue, "y")
+		}
+	}
+}
+
 func TestListGet(t *testing.T) {
 	list := New[string]()
 	list.Add("a")
@@ -130,6 +163,35 @@ func TestListClear(t *testing.T) {
 	}
 }
 
+func TestListNegativeIndex(t *testing.T) {
+	list := New[string]()
+	list.Add("a", "b", "c")
+
+	// index,expectedValue,expectedOk
+	tests := [][]interface{}{
+		{-1, "c", true},
+		{-3, "a", true},
+		{-4, "", false},
+		{3, "", false},
+	}
+	for _, test := range tests {
+		if actualValue, ok := list.Get(test[0].(int)); actualValue != test[1] || ok != test[2] {
+			t.Errorf("Got %v,%v expected %v,%v", actualValue, ok, test[1], test[2])
+		}
+	}
+
+	list.Remove(-4)
+	list.Remove(3)
+	list.Remove(-1)
+	if actualValue, expectedValue := fmt.Sprintf("%v", list.Values()), "[a b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.Remove(-2)
+	if actualValue, expectedValue := fmt.Sprintf("%v", list.Values()), "[b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func TestListContains(t *testing.T) {
 	list := New[string]()
 	list.Add("a")
@@ -152,6 +214,24 @@ func TestListContains(t *testing.T) {
 	}
 }
 
+func TestListEqual(t *testing.T) {
+	list := New[string]("a", "b", "c")
+	tests := [][]interface{}{
+		{New[string]("a", "b", "c"), true},
+		{New[string]("a", "c", "b"), false},
+		{New[string]("a", "b"), false},
+		{New[string](), false},
+	}
+	for _, test := range tests {
+		if actualValue := list.Equal(test[0].(*List[string])); actualValue != test[1] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+	}
+	if actualValue := New[string]().Equal(New[string]()); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
 func TestListValues(t *testing.T) {
 	list := New[string]()
 	list.Add("a")
@@ -161,6 +241,20 @@ func TestListValues(t *testing.T) {
 	}
 }
 
+func TestListReverseValues(t *testing.T) {
+	list := New[string]()
+	if actualValue, expectedValue := fmt.Sprintf("%v", list.ReverseValues()), "[]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.Add("a", "b", "c")
+	if actualValue, expectedValue := fmt.Sprintf("%v", list.ReverseValues()), "[c b a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", list.Values()), "[a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func TestListIndexOf(t *testing.T) {
 	list := New[string]()
 
@@ -334,6 +428,24 @@ func TestListFind(t *testing.T) {
 		t.Errorf("Got %v at %v expected %v at %v", foundValue, foundIndex, nil, nil)
 	}
 }
+
+func TestListMinMaxBy(t *testing.T) {
+	list := New[string]()
+	score := func(index int, value string) float64 {
+		return float64(len(value))
+	}
+	if _, _, ok := list.MinBy(score); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	list.Add("ccc", "a", "bb", "d", "eee")
+	if index, value, ok := list.MinBy(score); index != 1 || value != "a" || !ok {
+		t.Errorf("Got %v at %v expected %v at %v", value, index, "a", 1)
+	}
+	if index, value, ok := list.MaxBy(score); index != 0 || value != "ccc" || !ok {
+		t.Errorf("Got %v at %v expected %v at %v", value, index, "ccc", 0)
+	}
+}
+
 func TestListChaining(t *testing.T) {
 	list := New[string]()
 	list.Add("a", "b", "c")
@@ -421,6 +533,19 @@ func TestListIteratorFirst(t *testing.T) {
 	}
 }
 
+func TestListFromJSONInvalid(t *testing.T) {
+	list := New[int]()
+	list.Add(1, 2)
+	for _, data := range []string{`[3,"x",5]`, `[3,`, `{"a":1}`} {
+		if err := list.FromJSON([]byte(data)); err == nil {
+			t.Errorf("Expected error for %v", data)
+		}
+		if actualValue, expectedValue := fmt.Sprintf("%v", list.Values()), "[1 2]"; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
 func TestListSerialization(t *testing.T) {
 	list := New[string]()
 	list.Add("a", "b", "c")
@@ -471,6 +596,31 @@ func benchmarkRemove(b *testing.B, list *List[int], size int) {
 	}
 }
 
+func TestListSeal(t *testing.T) {
+	list := New[int](1, 2, 3)
+	list.Seal()
+	if actualValue := list.Sealed(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	assertFrozenPanic(t, func() { list.Add(4) })
+	assertFrozenPanic(t, func() { list.Remove(0) })
+	assertFrozenPanic(t, func() { list.Set(0, 5) })
+	assertFrozenPanic(t, func() { list.Sort(utils.IntComparator) })
+	if actualValue, expectedValue := fmt.Sprint(list.Values()), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+// assertFrozenPanic asserts that f panics because the container is frozen.
+func assertFrozenPanic(t *testing.T, f func()) {
+	defer func() {
+		if r := recover(); r != "container is frozen" {
+			t.Errorf("Got %v expected %v", r, "container is frozen")
+		}
+	}()
+	f()
+}
+
 func BenchmarkSinglyLinkedListGet100(b *testing.B) {
 	b.StopTimer()
 	size := 100
@@ -0,0 +1,173 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package cowmap implements a copy-on-write map backed by a hash map.
+//
+// Reads are lock-free and operate on an immutable snapshot of the map that is swapped atomically.
+// Writes are serialized by a mutex, copy the current snapshot, modify the copy and publish it.
+// Writes are therefore O(n), which makes this map suitable for read-mostly workloads with rare writes.
+//
+// Elements are unordered in the map.
+//
+// Structure is thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Copy-on-write
+package cowmap
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/lemonyxk/gods/maps"
+	"github.com/lemonyxk/gods/maps/hashmap"
+)
+
+func assertMapImplementation[T comparable, P any]() {
+	var _ maps.Map[T, P] = (*Map[T, P])(nil)
+}
+
+// Map holds an atomically swapped snapshot of a hash map
+type Map[T comparable, P any] struct {
+	snapshot atomic.Value // *hashmap.Map[T, P]
+	mutex    sync.Mutex
+	frozen   bool // guarded by mutex
+}
+
+// New instantiates a copy-on-write map.
+func New[T comparable, P any]() *Map[T, P] {
+	m := &Map[T, P]{}
+	m.snapshot.Store(hashmap.New[T, P]())
+	return m
+}
+
+// Put inserts element into the map.
+func (m *Map[T, P]) Put(key T, value P) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.checkFrozen()
+	clone := m.clone()
+	clone.Put(key, value)
+	m.snapshot.Store(clone)
+}
+
+// PutIfAbsent inserts element into the map only if the key is not in the map yet.
+// Returns the existing value and true if the key was found, otherwise the inserted value and false.
+// The lookup and the insertion happen atomically with respect to other writes.
+func (m *Map[T, P]) PutIfAbsent(key T, value P) (actual P, loaded bool) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if actual, loaded = m.load().Get(key); loaded {
+		return actual, true
+	}
+	m.checkFrozen()
+	clone := m.clone()
+	clone.Put(key, value)
+	m.snapshot.Store(clone)
+	return value, false
+}
+
+// Get searches the element in the map by key and returns its value or nil if key is not found in map.
+// Second return parameter is true if key was found, otherwise false.
+func (m *Map[T, P]) Get(key T) (value P, found bool) {
+	return m.load().Get(key)
+}
+
+// Remove removes the element from the map by key.
+func (m *Map[T, P]) Remove(key T) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.checkFrozen()
+	if _, found := m.load().Get(key); !found {
+		return
+	}
+	clone := m.clone()
+	clone.Remove(key)
+	m.snapshot.Store(clone)
+}
+
+// CompareAndSwap replaces the value of the key with newValue only if its current value equals oldValue,
+// as decided by the equal function or reflect.DeepEqual if equal is nil.
+// The comparison and the replacement happen atomically with respect to other writes.
+// Returns true if the value was swapped, false if the values differ or the key is not found in the map.
+func (m *Map[T, P]) CompareAndSwap(key T, oldValue, newValue P, equal func(a, b P) bool) bool {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.checkFrozen()
+	value, found := m.load().Get(key)
+	if !found || !maps.ValuesEqual(value, oldValue, equal) {
+		return false
+	}
+	clone := m.clone()
+	clone.Put(key, newValue)
+	m.snapshot.Store(clone)
+	return true
+}
+
+// Empty returns true if map does not contain any elements
+func (m *Map[T, P]) Empty() bool {
+	return m.load().Empty()
+}
+
+// Size returns number of elements in the map.
+func (m *Map[T, P]) Size() int {
+	return m.load().Size()
+}
+
+// Keys returns all keys (random order).
+func (m *Map[T, P]) Keys() []T {
+	return m.load().Keys()
+}
+
+// Values returns all values (random order).
+func (m *Map[T, P]) Values() []P {
+	return m.load().Values()
+}
+
+// Clear removes all elements from the map.
+func (m *Map[T, P]) Clear() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.checkFrozen()
+	m.snapshot.Store(hashmap.New[T, P]())
+}
+
+// Freeze makes the map immutable; later modifying calls panic with "container is frozen".
+// Reads remain allowed. Freezing is one-way.
+func (m *Map[T, P]) Freeze() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.frozen = true
+}
+
+// Frozen returns true if the map has been frozen.
+func (m *Map[T, P]) Frozen() bool {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.frozen
+}
+
+// String returns a string representation of container
+func (m *Map[T, P]) String() string {
+	str := "CowMap\n"
+	str += fmt.Sprintf("%v", m.load())
+	return str
+}
+
+// load returns the current snapshot, which must not be modified.
+func (m *Map[T, P]) load() *hashmap.Map[T, P] {
+	return m.snapshot.Load().(*hashmap.Map[T, P])
+}
+
+// clone returns a modifiable copy of the current snapshot. Must be called with the mutex held.
+func (m *Map[T, P]) clone() *hashmap.Map[T, P] {
+	return m.load().Clone()
+}
+
+// checkFrozen panics if the map has been frozen. The caller must hold the mutex.
+func (m *Map[T, P]) checkFrozen() {
+	if m.frozen {
+		panic("container is frozen")
+	}
+}
@@ -0,0 +1,281 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cowmap
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestMapPut(t *testing.T) {
+	m := New[int, string]()
+	m.Put(5, "e")
+	m.Put(6, "f")
+	m.Put(7, "g")
+	m.Put(3, "c")
+	m.Put(4, "d")
+	m.Put(1, "x")
+	m.Put(2, "b")
+	m.Put(1, "a") // overwrite
+
+	if actualValue := m.Size(); actualValue != 7 {
+		t.Errorf("Got %v expected %v", actualValue, 7)
+	}
+	if actualValue, expectedValue := m.Keys(), []int{1, 2, 3, 4, 5, 6, 7}; !sameElements(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.Values(), []string{"a", "b", "c", "d", "e", "f", "g"}; !sameElements(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// key,expectedValue,expectedFound
+	tests1 := [][]interface{}{
+		{1, "a", true},
+		{2, "b", true},
+		{3, "c", true},
+		{4, "d", true},
+		{5, "e", true},
+		{6, "f", true},
+		{7, "g", true},
+		{8, "", false},
+	}
+
+	for _, test := range tests1 {
+		// retrievals
+		actualValue, actualFound := m.Get(test[0].(int))
+		if actualValue != test[1] || actualFound != test[2] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+	}
+}
+
+func TestMapPutIfAbsent(t *testing.T) {
+	m := New[int, string]()
+	m.Put(1, "a")
+
+	if actualValue, loaded := m.PutIfAbsent(1, "x"); actualValue != "a" || !loaded {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, loaded, "a", true)
+	}
+	if actualValue, loaded := m.PutIfAbsent(2, "b"); actualValue != "b" || loaded {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, loaded, "b", false)
+	}
+	if actualValue, found := m.Get(1); actualValue != "a" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+	if actualValue := m.Size(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+}
+
+func TestMapRemove(t *testing.T) {
+	m := New[int, string]()
+	m.Put(1, "a")
+	m.Put(2, "b")
+	m.Put(3, "c")
+
+	m.Remove(2)
+	m.Remove(4)
+
+	if actualValue, expectedValue := m.Keys(), []int{1, 3}; !sameElements(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if _, found := m.Get(2); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+
+	m.Clear()
+	if actualValue := m.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestMapCompareAndSwap(t *testing.T) {
+	m := New[string, []int]()
+	m.Put("a", []int{1})
+	m.Put("b", []int{2})
+
+	if actualValue := m.CompareAndSwap("a", []int{1}, []int{3}, nil); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := m.CompareAndSwap("a", []int{1}, []int{4}, nil); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := m.CompareAndSwap("x", nil, []int{4}, nil); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	sameLength := func(a, b []int) bool {
+		return len(a) == len(b)
+	}
+	if actualValue := m.CompareAndSwap("b", []int{9}, []int{5}, sameLength); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, _ := m.Get("a"); len(actualValue) != 1 || actualValue[0] != 3 {
+		t.Errorf("Got %v expected %v", actualValue, []int{3})
+	}
+	if actualValue, _ := m.Get("b"); len(actualValue) != 1 || actualValue[0] != 5 {
+		t.Errorf("Got %v expected %v", actualValue, []int{5})
+	}
+	if _, found := m.Get("x"); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+}
+
+func TestMapSnapshotIsolation(t *testing.T) {
+	m := New[int, string]()
+	m.Put(1, "a")
+
+	snapshot := m.load()
+	m.Put(2, "b")
+	m.Remove(1)
+
+	if actualValue, expectedValue := snapshot.Keys(), []int{1}; !sameElements(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.Keys(), []int{2}; !sameElements(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapConcurrentAccess(t *testing.T) {
+	m := New[int, int]()
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(2)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				m.Put(w*100+i, i)
+			}
+		}(w)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				m.Get(i)
+				m.Size()
+			}
+		}()
+	}
+	wg.Wait()
+	if actualValue := m.Size(); actualValue != 400 {
+		t.Errorf("Got %v expected %v", actualValue, 400)
+	}
+}
+
+func TestMapConcurrentCompareAndSwap(t *testing.T) {
+	m := New[string, int]()
+	m.Put("counter", 0)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for n := 0; n < 100; n++ {
+				for {
+					value, _ := m.Get("counter")
+					if m.CompareAndSwap("counter", value, value+1, nil) {
+						break
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if actualValue, _ := m.Get("counter"); actualValue != 800 {
+		t.Errorf("Got %v expected %v", actualValue, 800)
+	}
+}
+
+func TestMapSerialization(t *testing.T) {
+	m := New[string, float64]()
+	m.Put("a", 1.0)
+	m.Put("b", 2.0)
+	m.Put("c", 3.0)
+
+	var err error
+	assert := func() {
+		if actualValue, expectedValue := m.Keys(), []string{"a", "b", "c"}; !sameElements(actualValue, expectedValue) {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := m.Values(), []float64{1.0, 2.0, 3.0}; !sameElements(actualValue, expectedValue) {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if err != nil {
+			t.Errorf("Got error %v", err)
+		}
+	}
+
+	assert()
+
+	json, err := m.ToJSON()
+	assert()
+
+	err = m.FromJSON(json)
+	assert()
+}
+
+func sameElements[T comparable](a []T, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, av := range a {
+		found := false
+		for _, bv := range b {
+			if av == bv {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func TestMapFreeze(t *testing.T) {
+	m := New[int, string]()
+	m.Put(1, "a")
+	m.Freeze()
+	if actualValue := m.Frozen(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	assertFrozenPanic(t, func() { m.Put(2, "b") })
+	assertFrozenPanic(t, func() { m.PutIfAbsent(2, "b") })
+	assertFrozenPanic(t, func() { m.Remove(1) })
+	assertFrozenPanic(t, func() { m.CompareAndSwap(1, "a", "b", nil) })
+	assertFrozenPanic(t, func() { m.Clear() })
+	assertFrozenPanic(t, func() { m.FromJSON([]byte(`{"2":"b"}`)) })
+	if actualValue, loaded := m.PutIfAbsent(1, "b"); actualValue != "a" || !loaded {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+	if actualValue, found := m.Get(1); actualValue != "a" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+}
+
+// assertFrozenPanic asserts that f panics because the container is frozen.
+func assertFrozenPanic(t *testing.T, f func()) {
+	defer func() {
+		if r := recover(); r != "container is frozen" {
+			t.Errorf("Got %v expected %v", r, "container is frozen")
+		}
+	}()
+	f()
+}
+
+func BenchmarkCowMapGet1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	m := New[int, struct{}]()
+	for n := 0; n < size; n++ {
+		m.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Get(n)
+		}
+	}
+}
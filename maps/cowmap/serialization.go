@@ -0,0 +1,54 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cowmap
+
+import (
+	"github.com/lemonyxk/gods/containers"
+	"github.com/lemonyxk/gods/maps"
+	"github.com/lemonyxk/gods/maps/hashmap"
+)
+
+func assertSerializationImplementation[T comparable, P any]() {
+	var _ containers.JSONSerializer = (*Map[T, P])(nil)
+	var _ containers.JSONDeserializer = (*Map[T, P])(nil)
+}
+
+// ToJSON outputs the JSON representation of the map.
+func (m *Map[T, P]) ToJSON() ([]byte, error) {
+	return m.load().ToJSON()
+}
+
+// FromJSON populates the map from the input JSON representation.
+func (m *Map[T, P]) FromJSON(data []byte) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.checkFrozen()
+	replacement := hashmap.New[T, P]()
+	err := replacement.FromJSON(data)
+	if err == nil {
+		m.snapshot.Store(replacement)
+	}
+	return err
+}
+
+// FromJSONStrict populates the map from the input JSON representation like FromJSON,
+// but returns an error naming the duplicate key if the input contains the same key more than once.
+// The map is left untouched if the input is rejected.
+func (m *Map[T, P]) FromJSONStrict(data []byte) error {
+	if err := maps.CheckDuplicateJSONKeys(data); err != nil {
+		return err
+	}
+	return m.FromJSON(data)
+}
+
+// FromJSONLimited populates the map from the input JSON representation like FromJSON,
+// but returns an error if arrays and objects in the input are nested deeper than maxDepth,
+// e.g. to guard against adversarial input. The map is left untouched if the input is rejected.
+func (m *Map[T, P]) FromJSONLimited(data []byte, maxDepth int) error {
+	if err := maps.CheckJSONDepth(data, maxDepth); err != nil {
+		return err
+	}
+	return m.FromJSON(data)
+}
@@ -30,15 +30,17 @@ func assertMapImplementation[T comparable, P comparable]() {
 type Map[T comparable, P comparable] struct {
 	forwardMap hashmap.Map[T, P]
 	inverseMap hashmap.Map[P, T]
+	frozen     bool
 }
 
 // New instantiates a bidirectional map.
 func New[T comparable, P comparable]() *Map[T, P] {
-	return &Map[T, P]{*hashmap.New[T, P](), *hashmap.New[P, T]()}
+	return &Map[T, P]{forwardMap: *hashmap.New[T, P](), inverseMap: *hashmap.New[P, T]()}
 }
 
 // Put inserts element into the map.
 func (m *Map[T, P]) Put(key T, value P) {
+	m.checkFrozen()
 	if valueByKey, ok := m.forwardMap.Get(key); ok {
 		m.inverseMap.Remove(valueByKey)
 	}
@@ -49,6 +51,17 @@ func (m *Map[T, P]) Put(key T, value P) {
 	m.inverseMap.Put(value, key)
 }
 
+// PutIfAbsent inserts element into the map only if the key is not in the map yet.
+// Returns the existing value and true if the key was found, otherwise the inserted value and false.
+// If the value is already mapped to another key, that key is removed from the map, as with Put.
+func (m *Map[T, P]) PutIfAbsent(key T, value P) (actual P, loaded bool) {
+	if actual, loaded = m.forwardMap.Get(key); loaded {
+		return actual, true
+	}
+	m.Put(key, value)
+	return value, false
+}
+
 // Get searches the element in the map by key and returns its value or nil if key is not found in map.
 // Second return parameter is true if key was found, otherwise false.
 func (m *Map[T, P]) Get(key T) (value P, found bool) {
@@ -63,12 +76,25 @@ func (m *Map[T, P]) GetKey(value P) (key T, found bool) {
 
 // Remove removes the element from the map by key.
 func (m *Map[T, P]) Remove(key T) {
+	m.checkFrozen()
 	if value, found := m.forwardMap.Get(key); found {
 		m.forwardMap.Remove(key)
 		m.inverseMap.Remove(value)
 	}
 }
 
+// RemoveByValue removes the element from the map by value.
+// Returns the key of the removed element and true, or nil and false if value is not found in map.
+func (m *Map[T, P]) RemoveByValue(value P) (key T, removed bool) {
+	m.checkFrozen()
+	key, removed = m.inverseMap.Get(value)
+	if removed {
+		m.inverseMap.Remove(value)
+		m.forwardMap.Remove(key)
+	}
+	return key, removed
+}
+
 // Empty returns true if map does not contain any elements
 func (m *Map[T, P]) Empty() bool {
 	return m.Size() == 0
@@ -91,13 +117,32 @@ func (m *Map[T, P]) Values() []P {
 
 // Clear removes all elements from the map.
 func (m *Map[T, P]) Clear() {
+	m.checkFrozen()
 	m.forwardMap.Clear()
 	m.inverseMap.Clear()
 }
 
+// Freeze makes the map immutable; later modifying calls panic with "container is frozen".
+// Reads remain allowed. Freezing is one-way.
+func (m *Map[T, P]) Freeze() {
+	m.frozen = true
+}
+
+// Frozen returns true if the map has been frozen.
+func (m *Map[T, P]) Frozen() bool {
+	return m.frozen
+}
+
 // String returns a string representation of container
 func (m *Map[T, P]) String() string {
 	str := "HashBidiMap\n"
 	str += fmt.Sprintf("%v", m.forwardMap)
 	return str
 }
+
+// checkFrozen panics if the map has been frozen.
+func (m *Map[T, P]) checkFrozen() {
+	if m.frozen {
+		panic("container is frozen")
+	}
+}
@@ -53,6 +53,33 @@ func TestMapPut(t *testing.T) {
 	}
 }
 
+func TestMapPutIfAbsent(t *testing.T) {
+	m := New[int, string]()
+	m.Put(1, "a")
+	m.Put(2, "b")
+
+	if actualValue, loaded := m.PutIfAbsent(1, "x"); actualValue != "a" || !loaded {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, loaded, "a", true)
+	}
+	if actualValue, found := m.GetKey("x"); found {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+
+	// the value is already mapped to another key, which is removed
+	if actualValue, loaded := m.PutIfAbsent(3, "b"); actualValue != "b" || loaded {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, loaded, "b", false)
+	}
+	if actualValue, found := m.Get(2); found {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue, found := m.GetKey("b"); actualValue != 3 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue := m.Size(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+}
+
 func TestMapRemove(t *testing.T) {
 	m := New[int, string]()
 	m.Put(5, "e")
@@ -120,6 +147,28 @@ func TestMapRemove(t *testing.T) {
 	}
 }
 
+func TestMapRemoveByValue(t *testing.T) {
+	m := New[int, string]()
+	m.Put(1, "a")
+	m.Put(2, "b")
+
+	if actualKey, actualRemoved := m.RemoveByValue("a"); actualKey != 1 || !actualRemoved {
+		t.Errorf("Got %v, %v expected %v, %v", actualKey, actualRemoved, 1, true)
+	}
+	if actualKey, actualRemoved := m.RemoveByValue("a"); actualKey != 0 || actualRemoved {
+		t.Errorf("Got %v, %v expected %v, %v", actualKey, actualRemoved, 0, false)
+	}
+	if _, found := m.Get(1); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+	if actualValue := m.Size(); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, found := m.GetKey("b"); actualValue != 2 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+}
+
 func TestMapGetKey(t *testing.T) {
 	m := New[int, string]()
 	m.Put(5, "e")
@@ -226,6 +275,36 @@ func benchmarkRemove(b *testing.B, m *Map[int, int], size int) {
 	}
 }
 
+func TestMapFreeze(t *testing.T) {
+	m := New[int, string]()
+	m.Put(1, "a")
+	m.Freeze()
+	if actualValue := m.Frozen(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	assertFrozenPanic(t, func() { m.Put(2, "b") })
+	assertFrozenPanic(t, func() { m.Remove(1) })
+	assertFrozenPanic(t, func() { m.RemoveByValue("a") })
+	assertFrozenPanic(t, func() { m.Clear() })
+	assertFrozenPanic(t, func() { m.FromJSON([]byte(`{"2":"b"}`)) })
+	if actualValue, loaded := m.PutIfAbsent(1, "b"); actualValue != "a" || !loaded {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+	if actualValue, found := m.Get(1); actualValue != "a" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+}
+
+// assertFrozenPanic asserts that f panics because the container is frozen.
+func assertFrozenPanic(t *testing.T, f func()) {
+	defer func() {
+		if r := recover(); r != "container is frozen" {
+			t.Errorf("Got %v expected %v", r, "container is frozen")
+		}
+	}()
+	f()
+}
+
 func BenchmarkHashBidiMapGet100(b *testing.B) {
 	b.StopTimer()
 	size := 100
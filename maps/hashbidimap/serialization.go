@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 
 	"github.com/emirpasic/gods/containers"
+	"github.com/lemonyxk/gods/maps"
 )
 
 func assertSerializationImplementation[T comparable, P comparable]() {
@@ -22,6 +23,7 @@ func (m *Map[T, P]) ToJSON() ([]byte, error) {
 
 // FromJSON populates the map from the input JSON representation.
 func (m *Map[T, P]) FromJSON(data []byte) error {
+	m.checkFrozen()
 	elements := make(map[T]P)
 	err := json.Unmarshal(data, &elements)
 	if err == nil {
@@ -32,3 +34,23 @@ func (m *Map[T, P]) FromJSON(data []byte) error {
 	}
 	return err
 }
+
+// FromJSONStrict populates the map from the input JSON representation like FromJSON,
+// but returns an error naming the duplicate key if the input contains the same key more than once.
+// The map is left untouched if the input is rejected.
+func (m *Map[T, P]) FromJSONStrict(data []byte) error {
+	if err := maps.CheckDuplicateJSONKeys(data); err != nil {
+		return err
+	}
+	return m.FromJSON(data)
+}
+
+// FromJSONLimited populates the map from the input JSON representation like FromJSON,
+// but returns an error if arrays and objects in the input are nested deeper than maxDepth,
+// e.g. to guard against adversarial input. The map is left untouched if the input is rejected.
+func (m *Map[T, P]) FromJSONLimited(data []byte, maxDepth int) error {
+	if err := maps.CheckJSONDepth(data, maxDepth); err != nil {
+		return err
+	}
+	return m.FromJSON(data)
+}
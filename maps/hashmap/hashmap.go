@@ -14,6 +14,7 @@ package hashmap
 import (
 	"fmt"
 
+	"github.com/lemonyxk/gods/containers"
 	"github.com/lemonyxk/gods/maps"
 )
 
@@ -23,7 +24,8 @@ func assertMapImplementation[T comparable, P any]() {
 
 // Map holds the elements in go's native map
 type Map[T comparable, P any] struct {
-	m map[T]P
+	m      map[T]P
+	frozen bool
 }
 
 // New instantiates a hash map.
@@ -31,11 +33,39 @@ func New[T comparable, P any]() *Map[T, P] {
 	return &Map[T, P]{m: make(map[T]P)}
 }
 
+// Zip instantiates a hash map pairing the keys and values of the two containers positionally,
+// e.g. the first key with the first value, in the order returned by their Values().
+// A key occurring more than once is mapped to its last value.
+// Returns an error if the containers differ in size.
+func Zip[T comparable, P any](keys containers.Container[T], values containers.Container[P]) (*Map[T, P], error) {
+	keyValues, valueValues := keys.Values(), values.Values()
+	if len(keyValues) != len(valueValues) {
+		return nil, fmt.Errorf("zip of %d keys and %d values", len(keyValues), len(valueValues))
+	}
+	m := &Map[T, P]{m: make(map[T]P, len(keyValues))}
+	for i, key := range keyValues {
+		m.m[key] = valueValues[i]
+	}
+	return m, nil
+}
+
 // Put inserts element into the map.
 func (m *Map[T, P]) Put(key T, value P) {
+	m.checkFrozen()
 	m.m[key] = value
 }
 
+// PutIfAbsent inserts element into the map only if the key is not in the map yet.
+// Returns the existing value and true if the key was found, otherwise the inserted value and false.
+func (m *Map[T, P]) PutIfAbsent(key T, value P) (actual P, loaded bool) {
+	if actual, loaded = m.m[key]; loaded {
+		return actual, true
+	}
+	m.checkFrozen()
+	m.m[key] = value
+	return value, false
+}
+
 // Get searches the element in the map by key and returns its value or nil if key is not found in map.
 // Second return parameter is true if key was found, otherwise false.
 func (m *Map[T, P]) Get(key T) (value P, found bool) {
@@ -43,11 +73,63 @@ func (m *Map[T, P]) Get(key T) (value P, found bool) {
 	return
 }
 
+// GetOrComputeErr returns the value of the key if it is in the map, otherwise it calls f with the key
+// and stores and returns the computed value, e.g. to load a cache entry from a database.
+// If f returns an error nothing is stored, so that a failed load does not leave a zero value behind, and the error is returned.
+func (m *Map[T, P]) GetOrComputeErr(key T, f func(key T) (P, error)) (P, error) {
+	if value, found := m.m[key]; found {
+		return value, nil
+	}
+	m.checkFrozen()
+	value, err := f(key)
+	if err != nil {
+		return value, err
+	}
+	m.m[key] = value
+	return value, nil
+}
+
+// GetOrDefault returns the value of the key if it is in the map, otherwise def.
+// Does not modify the map.
+func (m *Map[T, P]) GetOrDefault(key T, def P) P {
+	if value, found := m.m[key]; found {
+		return value
+	}
+	return def
+}
+
 // Remove removes the element from the map by key.
 func (m *Map[T, P]) Remove(key T) {
+	m.checkFrozen()
 	delete(m.m, key)
 }
 
+// Swap exchanges the values of the two given keys.
+// Returns false (and leaves the map untouched) if either key is not found in the map.
+func (m *Map[T, P]) Swap(key1, key2 T) bool {
+	m.checkFrozen()
+	value1, found1 := m.m[key1]
+	value2, found2 := m.m[key2]
+	if !found1 || !found2 {
+		return false
+	}
+	m.m[key1], m.m[key2] = value2, value1
+	return true
+}
+
+// CompareAndSwap replaces the value of the key with newValue only if its current value equals oldValue,
+// as decided by the equal function or reflect.DeepEqual if equal is nil.
+// Returns true if the value was swapped, false if the values differ or the key is not found in the map.
+func (m *Map[T, P]) CompareAndSwap(key T, oldValue, newValue P, equal func(a, b P) bool) bool {
+	m.checkFrozen()
+	value, found := m.m[key]
+	if !found || !maps.ValuesEqual(value, oldValue, equal) {
+		return false
+	}
+	m.m[key] = newValue
+	return true
+}
+
 // Empty returns true if map does not contain any elements
 func (m *Map[T, P]) Empty() bool {
 	return m.Size() == 0
@@ -80,14 +162,50 @@ func (m *Map[T, P]) Values() []P {
 	return values
 }
 
+// Clone returns an independent copy of the map holding the same elements, with values copied by assignment.
+// Modifying either map does not affect the other. The copy is never frozen.
+func (m *Map[T, P]) Clone() *Map[T, P] {
+	clone := &Map[T, P]{m: make(map[T]P, len(m.m))}
+	for key, value := range m.m {
+		clone.m[key] = value
+	}
+	return clone
+}
+
 // Clear removes all elements from the map.
 func (m *Map[T, P]) Clear() {
+	m.checkFrozen()
 	m.m = make(map[T]P)
 }
 
+// Reset removes all elements from the map like Clear, but keeps the allocated capacity of the map,
+// e.g. for a map that is emptied and refilled repeatedly with a similar number of elements.
+func (m *Map[T, P]) Reset() {
+	m.checkFrozen()
+	clear(m.m)
+}
+
+// Freeze makes the map immutable; later modifying calls panic with "container is frozen".
+// Reads remain allowed. Freezing is one-way.
+func (m *Map[T, P]) Freeze() {
+	m.frozen = true
+}
+
+// Frozen returns true if the map has been frozen.
+func (m *Map[T, P]) Frozen() bool {
+	return m.frozen
+}
+
 // String returns a string representation of container
 func (m *Map[T, P]) String() string {
 	str := "HashMap\n"
 	str += fmt.Sprintf("%v", m.m)
 	return str
 }
+
+// checkFrozen panics if the map has been frozen.
+func (m *Map[T, P]) checkFrozen() {
+	if m.frozen {
+		panic("container is frozen")
+	}
+}
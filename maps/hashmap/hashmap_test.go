@@ -5,9 +5,14 @@
 package hashmap
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"testing"
 
+	"github.com/lemonyxk/gods/lists/arraylist"
+	"github.com/lemonyxk/gods/lists/doublylinkedlist"
 	"github.com/lemonyxk/gods/utils"
 )
 
@@ -53,6 +58,124 @@ func TestMapPut(t *testing.T) {
 	}
 }
 
+func TestMapZip(t *testing.T) {
+	m, err := Zip[string, int](arraylist.New("a", "b", "a"), doublylinkedlist.New(1, 2, 3))
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue := m.Size(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, found := m.Get("a"); actualValue != 3 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, found := m.Get("b"); actualValue != 2 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+
+	if _, err := Zip[string, int](arraylist.New("a"), arraylist.New[int]()); err == nil {
+		t.Errorf("Expected error for different sizes")
+	}
+}
+
+func TestMapGetOrComputeErr(t *testing.T) {
+	m := New[int, string]()
+	m.Put(1, "a")
+	calls := 0
+	load := func(key int) (string, error) {
+		calls++
+		if key < 0 {
+			return "", errors.New("load failed")
+		}
+		return strconv.Itoa(key), nil
+	}
+
+	if actualValue, err := m.GetOrComputeErr(1, load); actualValue != "a" || err != nil || calls != 0 {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, err, "a", nil)
+	}
+	if actualValue, err := m.GetOrComputeErr(2, load); actualValue != "2" || err != nil || calls != 1 {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, err, "2", nil)
+	}
+	if actualValue, found := m.Get(2); actualValue != "2" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "2")
+	}
+	if _, err := m.GetOrComputeErr(-1, load); err == nil || err.Error() != "load failed" {
+		t.Errorf("Got %v expected %v", err, "load failed")
+	}
+	if _, found := m.Get(-1); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+	if actualValue := m.Size(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+}
+
+func TestMapPutIfAbsent(t *testing.T) {
+	m := New[int, string]()
+	m.Put(1, "a")
+
+	if actualValue, loaded := m.PutIfAbsent(1, "x"); actualValue != "a" || !loaded {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, loaded, "a", true)
+	}
+	if actualValue, loaded := m.PutIfAbsent(2, "b"); actualValue != "b" || loaded {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, loaded, "b", false)
+	}
+	if actualValue, found := m.Get(1); actualValue != "a" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+	if actualValue := m.Size(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+}
+
+func TestMapGetOrDefault(t *testing.T) {
+	m := New[int, string]()
+	m.Put(1, "a")
+
+	// key,expectedValue
+	tests := [][]interface{}{
+		{1, "a"},
+		{2, "z"},
+	}
+	for _, test := range tests {
+		if actualValue := m.GetOrDefault(test[0].(int), "z"); actualValue != test[1] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+	}
+	if actualValue := m.Size(); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+}
+
+func TestMapClone(t *testing.T) {
+	m := New[int, string]()
+	m.Put(2, "b")
+	m.Put(1, "a")
+	m.Freeze()
+
+	clone := m.Clone()
+	clone.Put(3, "c")
+	clone.Remove(1)
+
+	// key,expectedOriginal,expectedClone
+	tests := [][]interface{}{
+		{1, "a", nil},
+		{2, "b", "b"},
+		{3, nil, "c"},
+	}
+	for _, test := range tests {
+		if actualValue, found := m.Get(test[0].(int)); found != (test[1] != nil) || found && actualValue != test[1] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+		if actualValue, found := clone.Get(test[0].(int)); found != (test[2] != nil) || found && actualValue != test[2] {
+			t.Errorf("Got %v expected %v", actualValue, test[2])
+		}
+	}
+	if actualValue := clone.Frozen(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
 func TestMapRemove(t *testing.T) {
 	m := New[int, string]()
 	m.Put(5, "e")
@@ -120,6 +243,144 @@ func TestMapRemove(t *testing.T) {
 	}
 }
 
+func TestMapSwap(t *testing.T) {
+	m := New[int, string]()
+	m.Put(1, "a")
+	m.Put(2, "b")
+	m.Put(3, "c")
+
+	if actualValue := m.Swap(1, 3); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, _ := m.Get(1); actualValue != "c" {
+		t.Errorf("Got %v expected %v", actualValue, "c")
+	}
+	if actualValue, _ := m.Get(3); actualValue != "a" {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+	if actualValue := m.Swap(2, 4); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue, _ := m.Get(2); actualValue != "b" {
+		t.Errorf("Got %v expected %v", actualValue, "b")
+	}
+	if actualValue := m.Swap(2, 2); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := m.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+}
+
+func TestMapCompareAndSwap(t *testing.T) {
+	m := New[string, []int]()
+	m.Put("a", []int{1})
+	m.Put("b", []int{2})
+
+	if actualValue := m.CompareAndSwap("a", []int{1}, []int{3}, nil); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := m.CompareAndSwap("a", []int{1}, []int{4}, nil); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := m.CompareAndSwap("x", nil, []int{4}, nil); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	sameLength := func(a, b []int) bool {
+		return len(a) == len(b)
+	}
+	if actualValue := m.CompareAndSwap("b", []int{9}, []int{5}, sameLength); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, _ := m.Get("a"); len(actualValue) != 1 || actualValue[0] != 3 {
+		t.Errorf("Got %v expected %v", actualValue, []int{3})
+	}
+	if actualValue, _ := m.Get("b"); len(actualValue) != 1 || actualValue[0] != 5 {
+		t.Errorf("Got %v expected %v", actualValue, []int{5})
+	}
+	if _, found := m.Get("x"); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+}
+
+func TestMapReset(t *testing.T) {
+	m := New[int, string]()
+	m.Put(1, "a")
+	m.Put(2, "b")
+	m.Reset()
+	if actualValue := m.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if _, found := m.Get(1); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+	m.Put(3, "c")
+	if actualValue, found := m.Get(3); actualValue != "c" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "c")
+	}
+	if actualValue := m.Size(); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+}
+
+func TestMapFreeze(t *testing.T) {
+	m := New[int, string]()
+	m.Put(1, "a")
+	m.Freeze()
+	if actualValue := m.Frozen(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	assertFrozenPanic(t, func() { m.Put(2, "b") })
+	assertFrozenPanic(t, func() { m.Remove(1) })
+	assertFrozenPanic(t, func() { m.Clear() })
+	assertFrozenPanic(t, func() { m.FromJSON([]byte(`{"2":"b"}`)) })
+	if actualValue, found := m.Get(1); actualValue != "a" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+	if actualValue := m.Size(); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+}
+
+// assertFrozenPanic asserts that f panics because the container is frozen.
+func assertFrozenPanic(t *testing.T, f func()) {
+	defer func() {
+		if r := recover(); r != "container is frozen" {
+			t.Errorf("Got %v expected %v", r, "container is frozen")
+		}
+	}()
+	f()
+}
+
+func TestMapFromJSONInvalid(t *testing.T) {
+	m := New[string, int]()
+	m.Put("a", 1)
+	for _, data := range []string{`{"b":2,"c":"x"}`, `{"b":2,`, `[1]`} {
+		if err := m.FromJSON([]byte(data)); err == nil {
+			t.Errorf("Expected error for %v", data)
+		}
+		if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[a]"; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestMapToJSONWithKeyFunc(t *testing.T) {
+	m := New[int, string]()
+	m.Put(10, "j")
+	m.Put(100, "x")
+	m.Put(2, "b")
+	json, err := m.ToJSONWithKeyFunc(func(key int) string {
+		return fmt.Sprintf("%03d", key)
+	})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(json), `{"002":"b","010":"j","100":"x"}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func TestMapSerialization(t *testing.T) {
 	m := New[string, float64]()
 	m.Put("a", 1.0)
@@ -151,6 +412,62 @@ func TestMapSerialization(t *testing.T) {
 	assert()
 }
 
+func TestMapFromJSONStrict(t *testing.T) {
+	m := New[string, int]()
+	m.Put("z", 26)
+
+	if err := m.FromJSONStrict([]byte(`{"a":1,"b":2,"a":3}`)); err == nil || err.Error() != `duplicate key "a"` {
+		t.Errorf("Got %v expected %v", err, `duplicate key "a"`)
+	}
+	if actualValue, expectedValue := m.Keys(), []string{"z"}; !sameElements(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// only the keys of the top-level object are checked
+	nested := New[string, map[string]int]()
+	if err := nested.FromJSONStrict([]byte(`{"a":{"c":1,"c":2}}`)); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, found := nested.Get("a"); !found || actualValue["c"] != 2 {
+		t.Errorf("Got %v expected %v", actualValue, map[string]int{"c": 2})
+	}
+
+	if err := m.FromJSONStrict([]byte(`{"a":1,"b":2}`)); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := m.Keys(), []string{"a", "b"}; !sameElements(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := m.FromJSONStrict([]byte(`{"a":1,`)); err == nil {
+		t.Errorf("Expected error")
+	}
+}
+
+func TestMapFromJSONLimited(t *testing.T) {
+	m := New[string, []interface{}]()
+	m.Put("z", nil)
+
+	deep := `{"a":` + strings.Repeat("[", 100) + strings.Repeat("]", 100) + `}`
+	if err := m.FromJSONLimited([]byte(deep), 10); err == nil || err.Error() != "nesting exceeds maximum depth 10" {
+		t.Errorf("Got %v expected %v", err, "nesting exceeds maximum depth 10")
+	}
+	if actualValue, expectedValue := m.Keys(), []string{"z"}; !sameElements(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := m.FromJSONLimited([]byte(`{"a":[[1]],"b":[]}`), 3); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := m.Keys(), []string{"a", "b"}; !sameElements(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := m.FromJSONLimited([]byte(`{"a":[[1]]}`), 2); err == nil {
+		t.Errorf("Expected error")
+	}
+	if err := m.FromJSONLimited([]byte(`{"a":[`), 10); err == nil {
+		t.Errorf("Expected error")
+	}
+}
+
 func sameElements[T comparable](a []T, b []T) bool {
 	if len(a) != len(b) {
 		return false
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 
 	"github.com/lemonyxk/gods/containers"
+	"github.com/lemonyxk/gods/maps"
 	"github.com/lemonyxk/gods/utils"
 )
 
@@ -20,13 +21,29 @@ func assertSerializationImplementation[T comparable, P any]() {
 func (m *Map[T, P]) ToJSON() ([]byte, error) {
 	elements := make(map[string]interface{})
 	for key, value := range m.m {
-		elements[utils.ToString(key)] = value
+		jsonKey, err := utils.ToJSONKey(key)
+		if err != nil {
+			return nil, err
+		}
+		elements[jsonKey] = value
+	}
+	return json.Marshal(&elements)
+}
+
+// ToJSONWithKeyFunc outputs the JSON representation of the map like ToJSON, but keys are formatted with the given function,
+// e.g. to zero-pad integer keys. The function should map distinct keys to distinct strings.
+func (m *Map[T, P]) ToJSONWithKeyFunc(keyFn func(key T) string) ([]byte, error) {
+	elements := make(map[string]interface{}, len(m.m))
+	for key, value := range m.m {
+		elements[keyFn(key)] = value
 	}
 	return json.Marshal(&elements)
 }
 
 // FromJSON populates the map from the input JSON representation.
+// The map is left untouched if the input can not be decoded.
 func (m *Map[T, P]) FromJSON(data []byte) error {
+	m.checkFrozen()
 	elements := make(map[T]P)
 	err := json.Unmarshal(data, &elements)
 	if err == nil {
@@ -37,3 +54,23 @@ func (m *Map[T, P]) FromJSON(data []byte) error {
 	}
 	return err
 }
+
+// FromJSONStrict populates the map from the input JSON representation like FromJSON,
+// but returns an error naming the duplicate key if the input contains the same key more than once.
+// The map is left untouched if the input is rejected.
+func (m *Map[T, P]) FromJSONStrict(data []byte) error {
+	if err := maps.CheckDuplicateJSONKeys(data); err != nil {
+		return err
+	}
+	return m.FromJSON(data)
+}
+
+// FromJSONLimited populates the map from the input JSON representation like FromJSON,
+// but returns an error if arrays and objects in the input are nested deeper than maxDepth,
+// e.g. to guard against adversarial input. The map is left untouched if the input is rejected.
+func (m *Map[T, P]) FromJSONLimited(data []byte, maxDepth int) error {
+	if err := maps.CheckJSONDepth(data, maxDepth); err != nil {
+		return err
+	}
+	return m.FromJSON(data)
+}
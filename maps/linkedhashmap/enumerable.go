@@ -24,7 +24,7 @@ func (m *Map[T, P]) Each(f func(key T, value P)) {
 // Map invokes the given function once for each element and returns a container
 // containing the values returned by the given function as key/value pairs.
 func (m *Map[T, P]) Map(f func(key1 T, value1 P) (T, P)) *Map[T, P] {
-	newMap := New[T, P]()
+	newMap := m.empty()
 	iterator := m.Iterator()
 	for iterator.Next() {
 		key2, value2 := f(iterator.Key(), iterator.Value())
@@ -35,7 +35,7 @@ func (m *Map[T, P]) Map(f func(key1 T, value1 P) (T, P)) *Map[T, P] {
 
 // Select returns a new container containing all elements for which the given function returns a true value.
 func (m *Map[T, P]) Select(f func(key T, value P) bool) *Map[T, P] {
-	newMap := New[T, P]()
+	newMap := m.empty()
 	iterator := m.Iterator()
 	for iterator.Next() {
 		if f(iterator.Key(), iterator.Value()) {
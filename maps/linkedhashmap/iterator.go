@@ -17,13 +17,15 @@ func assertIteratorImplementation[T comparable, P any]() {
 type Iterator[T comparable, P any] struct {
 	iterator doublylinkedlist.Iterator[T]
 	table    map[T]P
+	original map[T]T
 }
 
 // Iterator returns a stateful iterator whose elements are key/value pairs.
 func (m *Map[T, P]) Iterator() Iterator[T, P] {
 	return Iterator[T, P]{
 		iterator: m.ordering.Iterator(),
-		table:    m.table}
+		table:    m.table,
+		original: m.original}
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -51,7 +53,17 @@ func (iterator *Iterator[T, P]) Value() P {
 // Key returns the current element's key.
 // Does not modify the state of the iterator.
 func (iterator *Iterator[T, P]) Key() T {
-	return iterator.iterator.Value()
+	key := iterator.iterator.Value()
+	if iterator.original != nil {
+		return iterator.original[key]
+	}
+	return key
+}
+
+// KeyValue returns the current element's key and value as an entry.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[T, P]) KeyValue() containers.Entry[T, P] {
+	return containers.Entry[T, P]{Key: iterator.Key(), Value: iterator.Value()}
 }
 
 // Begin resets the iterator to its initial state (one-before-first)
@@ -60,6 +72,19 @@ func (iterator *Iterator[T, P]) Begin() {
 	iterator.iterator.Begin()
 }
 
+// Reset resets the iterator to its initial state (one-before-first), same as Begin().
+// Call Next() to fetch the first element if any.
+func (iterator *Iterator[T, P]) Reset() {
+	iterator.Begin()
+}
+
+// Done returns true if the iterator is past the last element (one-past-the-end),
+// e.g. after Next() returned false or End() was called.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[T, P]) Done() bool {
+	return iterator.iterator.Index() >= len(iterator.table)
+}
+
 // End moves the iterator past the last element (one-past-the-end).
 // Call Prev() to fetch the last element if any.
 func (iterator *Iterator[T, P]) End() {
@@ -15,8 +15,10 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/lemonyxk/gods/containers"
 	"github.com/lemonyxk/gods/lists/doublylinkedlist"
 	"github.com/lemonyxk/gods/maps"
+	"github.com/lemonyxk/gods/utils"
 )
 
 func assertMapImplementation[T comparable, P any]() {
@@ -27,6 +29,13 @@ func assertMapImplementation[T comparable, P any]() {
 type Map[T comparable, P any] struct {
 	table    map[T]P
 	ordering *doublylinkedlist.List[T]
+	fold     func(key T) T // maps keys to the canonical form used for lookups, nil if keys are used as they are
+	original map[T]T       // canonical keys to their originally inserted form, only used with fold
+	capacity int
+	frozen   bool
+
+	// OnEvict is called with each element evicted because the map grew beyond its capacity, if set.
+	OnEvict func(key T, value P)
 }
 
 // New instantiates a linked-hash-map.
@@ -37,33 +46,100 @@ func New[T comparable, P any]() *Map[T, P] {
 	}
 }
 
+// NewWithCapacity instantiates a linked-hash-map holding at most max elements, e.g. as a bounded cache.
+// Whenever a Put grows the map beyond max elements, the oldest element in insertion-order is evicted
+// and passed to OnEvict, if set. A max that is not positive leaves the map unbounded.
+func NewWithCapacity[T comparable, P any](max int) *Map[T, P] {
+	m := New[T, P]()
+	m.capacity = max
+	return m
+}
+
+// NewCaseInsensitive instantiates a linked-hash-map with string keys matched regardless of their case,
+// e.g. for HTTP header semantics. Lookups compare the lower-cased keys, while Keys(), iteration and
+// serialization return each key in the form it was first inserted with.
+func NewCaseInsensitive[P any]() *Map[string, P] {
+	m := New[string, P]()
+	m.fold = strings.ToLower
+	m.original = make(map[string]string)
+	return m
+}
+
 // Put inserts key-value pair into the map.
 // Key should adhere to the comparator's type assertion, otherwise method panics.
 func (m *Map[T, P]) Put(key T, value P) {
-	if _, contains := m.table[key]; !contains {
-		m.ordering.Append(key)
+	m.checkFrozen()
+	canonical := m.canonical(key)
+	if _, contains := m.table[canonical]; !contains {
+		m.ordering.Append(canonical)
+		if m.fold != nil {
+			m.original[canonical] = key
+		}
 	}
-	m.table[key] = value
+	m.table[canonical] = value
+	m.evict()
+}
+
+// PutIfAbsent inserts element into the map only if the key is not in the map yet.
+// Returns the existing value and true if the key was found, otherwise the inserted value and false.
+// Insertion-order is only affected if the element is inserted.
+func (m *Map[T, P]) PutIfAbsent(key T, value P) (actual P, loaded bool) {
+	if actual, loaded = m.table[m.canonical(key)]; loaded {
+		return actual, true
+	}
+	m.Put(key, value)
+	return value, false
 }
 
 // Get searches the element in the map by key and returns its value or nil if key is not found in tree.
 // Second return parameter is true if key was found, otherwise false.
 // Key should adhere to the comparator's type assertion, otherwise method panics.
 func (m *Map[T, P]) Get(key T) (value P, found bool) {
-	value, found = m.table[key]
+	value, found = m.table[m.canonical(key)]
 	return
 }
 
+// GetOrDefault returns the value of the key if it is in the map, otherwise def.
+// Does not modify the map.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[T, P]) GetOrDefault(key T, def P) P {
+	if value, found := m.table[m.canonical(key)]; found {
+		return value
+	}
+	return def
+}
+
 // Remove removes the element from the map by key.
 // Key should adhere to the comparator's type assertion, otherwise method panics.
 func (m *Map[T, P]) Remove(key T) {
+	m.checkFrozen()
+	key = m.canonical(key)
 	if _, contains := m.table[key]; contains {
 		delete(m.table, key)
+		if m.fold != nil {
+			delete(m.original, key)
+		}
 		index := m.ordering.IndexOf(key)
 		m.ordering.Remove(index)
 	}
 }
 
+// IndexOf returns the 0-based insertion-order index of the key or -1 if key is not found in map.
+// Requires a linear walk over the ordering, i.e. O(n).
+func (m *Map[T, P]) IndexOf(key T) int {
+	key = m.canonical(key)
+	if _, contains := m.table[key]; !contains {
+		return -1
+	}
+	it := m.ordering.Iterator()
+	for it.Next() {
+		if it.Value() == key {
+			return it.Index()
+		}
+	}
+	return -1
+}
+
 // Empty returns true if map does not contain any elements
 func (m *Map[T, P]) Empty() bool {
 	return m.Size() == 0
@@ -76,7 +152,13 @@ func (m *Map[T, P]) Size() int {
 
 // Keys returns all keys in-order
 func (m *Map[T, P]) Keys() []T {
-	return m.ordering.Values()
+	keys := m.ordering.Values()
+	if m.fold != nil {
+		for i, key := range keys {
+			keys[i] = m.original[key]
+		}
+	}
+	return keys
 }
 
 // Values returns all values in-order based on the key.
@@ -91,12 +173,49 @@ func (m *Map[T, P]) Values() []P {
 	return values
 }
 
+// SortedKeys returns all keys sorted with respect to the given comparator.
+// Does not effect the insertion-order of elements within the map.
+func (m *Map[T, P]) SortedKeys(comparator utils.Comparator) []T {
+	keys := m.Keys()
+	utils.Sort(keys, comparator)
+	return keys
+}
+
+// SortedEntries returns all key-value pairs sorted by key with respect to the given comparator.
+// Does not effect the insertion-order of elements within the map.
+func (m *Map[T, P]) SortedEntries(comparator utils.Comparator) []containers.Entry[T, P] {
+	entries := make([]containers.Entry[T, P], 0, m.Size())
+	it := m.Iterator()
+	for it.Next() {
+		entries = append(entries, it.KeyValue())
+	}
+	utils.Sort(entries, func(a, b interface{}) int {
+		return comparator(a.(containers.Entry[T, P]).Key, b.(containers.Entry[T, P]).Key)
+	})
+	return entries
+}
+
 // Clear removes all elements from the map.
 func (m *Map[T, P]) Clear() {
+	m.checkFrozen()
 	m.table = make(map[T]P)
+	if m.fold != nil {
+		m.original = make(map[T]T)
+	}
 	m.ordering.Clear()
 }
 
+// Freeze makes the map immutable; later modifying calls panic with "container is frozen".
+// Reads remain allowed. Freezing is one-way.
+func (m *Map[T, P]) Freeze() {
+	m.frozen = true
+}
+
+// Frozen returns true if the map has been frozen.
+func (m *Map[T, P]) Frozen() bool {
+	return m.frozen
+}
+
 // String returns a string representation of container
 func (m *Map[T, P]) String() string {
 	str := "LinkedHashMap\nmap["
@@ -107,3 +226,46 @@ func (m *Map[T, P]) String() string {
 	return strings.TrimRight(str, " ") + "]"
 
 }
+
+// evict removes the oldest elements while the map holds more elements than its capacity.
+func (m *Map[T, P]) evict() {
+	for m.capacity > 0 && m.Size() > m.capacity {
+		key, _ := m.ordering.Get(0)
+		value := m.table[key]
+		original := key
+		delete(m.table, key)
+		if m.fold != nil {
+			original = m.original[key]
+			delete(m.original, key)
+		}
+		m.ordering.Remove(0)
+		if m.OnEvict != nil {
+			m.OnEvict(original, value)
+		}
+	}
+}
+
+// empty returns a new empty map matching keys the same way as this map.
+func (m *Map[T, P]) empty() *Map[T, P] {
+	newMap := New[T, P]()
+	if m.fold != nil {
+		newMap.fold = m.fold
+		newMap.original = make(map[T]T)
+	}
+	return newMap
+}
+
+// canonical returns the form of the key used for lookups in the table and the ordering.
+func (m *Map[T, P]) canonical(key T) T {
+	if m.fold != nil {
+		return m.fold(key)
+	}
+	return key
+}
+
+// checkFrozen panics if the map has been frozen.
+func (m *Map[T, P]) checkFrozen() {
+	if m.frozen {
+		panic("container is frozen")
+	}
+}
@@ -6,6 +6,8 @@ package linkedhashmap
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/lemonyxk/gods/utils"
@@ -120,6 +122,144 @@ func TestMapRemove(t *testing.T) {
 	}
 }
 
+func TestMapCaseInsensitive(t *testing.T) {
+	m := NewCaseInsensitive[string]()
+	m.Put("Content-Type", "text/plain")
+	m.Put("Accept", "*/*")
+	m.Put("content-type", "application/json")
+
+	if actualValue := m.Size(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, found := m.Get("CONTENT-TYPE"); actualValue != "application/json" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "application/json")
+	}
+	if actualValue, expectedValue := fmt.Sprint(m.Keys()), "[Content-Type Accept]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := m.IndexOf("accept"); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, expectedValue := m.String(), "LinkedHashMap\nmap[Content-Type:application/json Accept:*/*]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(m.Select(func(key string, value string) bool { return true }).Keys()), "[Content-Type Accept]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	m.Remove("CONTENT-type")
+	if actualValue, found := m.Get("Content-Type"); actualValue != "" || found {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	m.Put("content-TYPE", "text/html")
+	if actualValue, expectedValue := fmt.Sprint(m.Keys()), "[Accept content-TYPE]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	m.Clear()
+	if actualValue := m.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestMapPutIfAbsent(t *testing.T) {
+	m := New[int, string]()
+	m.Put(1, "a")
+
+	if actualValue, loaded := m.PutIfAbsent(1, "x"); actualValue != "a" || !loaded {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, loaded, "a", true)
+	}
+	if actualValue, loaded := m.PutIfAbsent(2, "b"); actualValue != "b" || loaded {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, loaded, "b", false)
+	}
+	if actualValue, expectedValue := fmt.Sprint(m.Keys(), m.Values()), "[1 2] [a b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapGetOrDefault(t *testing.T) {
+	m := New[int, string]()
+	m.Put(1, "a")
+
+	// key,expectedValue
+	tests := [][]interface{}{
+		{1, "a"},
+		{2, "z"},
+	}
+	for _, test := range tests {
+		if actualValue := m.GetOrDefault(test[0].(int), "z"); actualValue != test[1] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+	}
+	if actualValue := m.Size(); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+}
+
+func TestMapWithCapacity(t *testing.T) {
+	m := NewWithCapacity[int, string](2)
+	var evicted []string
+	m.OnEvict = func(key int, value string) {
+		evicted = append(evicted, fmt.Sprintf("%v:%v", key, value))
+	}
+	m.Put(1, "a")
+	m.Put(2, "b")
+	m.Put(1, "x")
+	if actualValue := len(evicted); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	m.Put(3, "c")
+	m.PutIfAbsent(4, "d")
+	if actualValue, expectedValue := fmt.Sprint(evicted), "[1:x 2:b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(m.Keys()), "[3 4]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if _, found := m.Get(1); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+
+	unbounded := NewWithCapacity[int, string](0)
+	for i := 0; i < 10; i++ {
+		unbounded.Put(i, "")
+	}
+	if actualValue := unbounded.Size(); actualValue != 10 {
+		t.Errorf("Got %v expected %v", actualValue, 10)
+	}
+}
+
+func TestMapFromJSONStrict(t *testing.T) {
+	m := New[string, int]()
+	m.Put("z", 26)
+
+	if err := m.FromJSONStrict([]byte(`{"a":1,"b":2,"a":3}`)); err == nil || err.Error() != `duplicate key "a"` {
+		t.Errorf("Got %v expected %v", err, `duplicate key "a"`)
+	}
+	if actualValue, expectedValue := m.Keys(), []string{"z"}; !sameElements(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// only the keys of the top-level object are checked
+	nested := New[string, map[string]int]()
+	if err := nested.FromJSONStrict([]byte(`{"a":{"c":1,"c":2}}`)); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, found := nested.Get("a"); !found || actualValue["c"] != 2 {
+		t.Errorf("Got %v expected %v", actualValue, map[string]int{"c": 2})
+	}
+
+	if err := m.FromJSONStrict([]byte(`{"a":1,"b":2}`)); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := m.Keys(), []string{"a", "b"}; !sameElements(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := m.FromJSONStrict([]byte(`{"a":1,`)); err == nil {
+		t.Errorf("Expected error")
+	}
+}
+
 func sameElements[T comparable](a []T, b []T) bool {
 	// If one is nil, the other must also be nil.
 	if (a == nil) != (b == nil) {
@@ -139,6 +279,51 @@ func sameElements[T comparable](a []T, b []T) bool {
 	return true
 }
 
+func TestMapIndexOf(t *testing.T) {
+	m := New[string, int]()
+	if actualValue := m.IndexOf("a"); actualValue != -1 {
+		t.Errorf("Got %v expected %v", actualValue, -1)
+	}
+	m.Put("c", 1)
+	m.Put("a", 2)
+	m.Put("b", 3)
+	m.Put("c", 4) // overwrite keeps position
+
+	tests := [][]interface{}{
+		{"c", 0},
+		{"a", 1},
+		{"b", 2},
+		{"d", -1},
+	}
+	for _, test := range tests {
+		if actualValue := m.IndexOf(test[0].(string)); actualValue != test[1] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+	}
+
+	m.Remove("a")
+	if actualValue := m.IndexOf("b"); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+}
+
+func TestMapSortedKeys(t *testing.T) {
+	m := New[string, int]()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.SortedKeys(utils.StringComparator)), "[a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.SortedEntries(utils.StringComparator)), "[{a 1} {b 2} {c 3}]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[c a b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func TestMapEach(t *testing.T) {
 	m := New[string, int]()
 	m.Put("c", 1)
@@ -385,6 +570,40 @@ func TestMapIteratorPrev(t *testing.T) {
 	}
 }
 
+func TestMapIteratorResetDone(t *testing.T) {
+	m := New[int, string]()
+	m.Put(3, "c")
+	m.Put(1, "a")
+	m.Put(2, "b")
+	it := m.Iterator()
+	if actualValue := it.Done(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	it.Next()
+	it.Next()
+	if actualValue := it.Done(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	for it.Next() {
+	}
+	if actualValue := it.Done(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	it.Reset()
+	if actualValue := it.Done(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if !it.Next() || it.Key() != 3 {
+		t.Errorf("Got %v expected %v", it.Key(), 3)
+	}
+
+	it.Reset()
+	it.End()
+	if actualValue := it.Done(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
 func TestMapIteratorBegin(t *testing.T) {
 	m := New[int, string]()
 	it := m.Iterator()
@@ -442,6 +661,74 @@ func TestMapIteratorLast(t *testing.T) {
 	}
 }
 
+func TestMapToJSONWithKeyFunc(t *testing.T) {
+	m := New[int, string]()
+	m.Put(10, "j")
+	m.Put(100, "x")
+	m.Put(2, "b")
+	json, err := m.ToJSONWithKeyFunc(func(key int) string {
+		return fmt.Sprintf("%03d", key)
+	})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(json), `{"010":"j","100":"x","002":"b"}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+type idKey struct {
+	n int
+}
+
+func (key idKey) MarshalText() ([]byte, error) {
+	return []byte("id-" + strconv.Itoa(key.n)), nil
+}
+
+func (key *idKey) UnmarshalText(text []byte) error {
+	n, err := strconv.Atoi(strings.TrimPrefix(string(text), "id-"))
+	key.n = n
+	return err
+}
+
+func TestMapSerializationTextKeys(t *testing.T) {
+	original := New[idKey, int]()
+	original.Put(idKey{2}, 2)
+	original.Put(idKey{1}, 1)
+
+	serialized, err := original.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(serialized), `{"id-2":2,"id-1":1}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	deserialized := New[idKey, int]()
+	if err := deserialized.FromJSON(serialized); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(deserialized.Keys(), deserialized.Values()), "[{2} {1}] [2 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	ints := New[int, string]()
+	if err := ints.FromJSON([]byte(`{"10":"j","2":"b"}`)); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(ints.Keys()), "[10 2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	ints.Put(7, "g")
+	if err := ints.FromJSON([]byte(`{"x":"a"}`)); err == nil {
+		t.Errorf("Got %v expected %v", err, "invalid key")
+	}
+	if actualValue := ints.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+}
+
 func TestMapSerialization(t *testing.T) {
 	for i := 0; i < 10; i++ {
 		original := New[string, string]()
@@ -515,6 +802,35 @@ func benchmarkRemove(b *testing.B, m *Map[int, struct{}], size int) {
 	}
 }
 
+func TestMapFreeze(t *testing.T) {
+	m := New[int, string]()
+	m.Put(1, "a")
+	m.Freeze()
+	if actualValue := m.Frozen(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	assertFrozenPanic(t, func() { m.Put(2, "b") })
+	assertFrozenPanic(t, func() { m.Remove(1) })
+	assertFrozenPanic(t, func() { m.Clear() })
+	assertFrozenPanic(t, func() { m.FromJSON([]byte(`{"2":"b"}`)) })
+	if actualValue, loaded := m.PutIfAbsent(1, "b"); actualValue != "a" || !loaded {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+	if actualValue, found := m.Get(1); actualValue != "a" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+}
+
+// assertFrozenPanic asserts that f panics because the container is frozen.
+func assertFrozenPanic(t *testing.T, f func()) {
+	defer func() {
+		if r := recover(); r != "container is frozen" {
+			t.Errorf("Got %v expected %v", r, "container is frozen")
+		}
+	}()
+	f()
+}
+
 func BenchmarkTreeMapGet100(b *testing.B) {
 	b.StopTimer()
 	size := 100
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 
 	"github.com/lemonyxk/gods/containers"
+	"github.com/lemonyxk/gods/maps"
 	"github.com/lemonyxk/gods/utils"
 )
 
@@ -19,6 +20,20 @@ func assertSerializationImplementation[T comparable, P any]() {
 
 // ToJSON outputs the JSON representation of map.
 func (m *Map[T, P]) ToJSON() ([]byte, error) {
+	return m.toJSON(func(key T) (string, error) {
+		return utils.ToJSONKey(key)
+	})
+}
+
+// ToJSONWithKeyFunc outputs the JSON representation of map like ToJSON, but keys are formatted with the given function,
+// e.g. to zero-pad integer keys. The function should map distinct keys to distinct strings.
+func (m *Map[T, P]) ToJSONWithKeyFunc(keyFn func(key T) string) ([]byte, error) {
+	return m.toJSON(func(key T) (string, error) {
+		return keyFn(key), nil
+	})
+}
+
+func (m *Map[T, P]) toJSON(keyFn func(key T) (string, error)) ([]byte, error) {
 	var b []byte
 	buf := bytes.NewBuffer(b)
 
@@ -29,7 +44,11 @@ func (m *Map[T, P]) ToJSON() ([]byte, error) {
 	index := 0
 
 	for it.Next() {
-		km, err := json.Marshal(it.Key())
+		key, err := keyFn(it.Key())
+		if err != nil {
+			return nil, err
+		}
+		km, err := json.Marshal(key)
 		if err != nil {
 			return nil, err
 		}
@@ -68,8 +87,11 @@ func (m *Map[T, P]) ToJSON() ([]byte, error) {
 //	return err
 // }
 
-// FromJSON populates map from the input JSON representation.
+// FromJSON populates map from the input JSON representation, keeping the order of the elements in the input.
+// Keys are decoded like encoding/json decodes map keys, e.g. through encoding.TextUnmarshaler.
+// The map is left untouched if the input can not be decoded.
 func (m *Map[T, P]) FromJSON(data []byte) error {
+	m.checkFrozen()
 	elements := make(map[string]P)
 	err := json.Unmarshal(data, &elements)
 	if err != nil {
@@ -94,11 +116,52 @@ func (m *Map[T, P]) FromJSON(data []byte) error {
 
 	utils.Sort(keys, byIndex)
 
+	typedKeys := make([]T, len(keys))
+	for i, key := range keys {
+		if typedKeys[i], err = unmarshalKey[T](key.(string)); err != nil {
+			return err
+		}
+	}
+
 	m.Clear()
 
-	for _, key := range keys {
-		m.Put(key.(T), elements[key.(string)])
+	for i, key := range keys {
+		m.Put(typedKeys[i], elements[key.(string)])
 	}
 
 	return nil
 }
+
+// unmarshalKey converts a JSON object key into T the way encoding/json decodes map keys,
+// i.e. strings as they are, integers parsed and other types through encoding.TextUnmarshaler.
+func unmarshalKey[T comparable](key string) (T, error) {
+	var single map[T]struct{}
+	esc, _ := json.Marshal(key)
+	if err := json.Unmarshal(append(append([]byte("{"), esc...), ":{}}"...), &single); err != nil {
+		return utils.AnyEmpty[T](), err
+	}
+	for typed := range single {
+		return typed, nil
+	}
+	return utils.AnyEmpty[T](), nil
+}
+
+// FromJSONStrict populates the map from the input JSON representation like FromJSON,
+// but returns an error naming the duplicate key if the input contains the same key more than once.
+// The map is left untouched if the input is rejected.
+func (m *Map[T, P]) FromJSONStrict(data []byte) error {
+	if err := maps.CheckDuplicateJSONKeys(data); err != nil {
+		return err
+	}
+	return m.FromJSON(data)
+}
+
+// FromJSONLimited populates the map from the input JSON representation like FromJSON,
+// but returns an error if arrays and objects in the input are nested deeper than maxDepth,
+// e.g. to guard against adversarial input. The map is left untouched if the input is rejected.
+func (m *Map[T, P]) FromJSONLimited(data []byte, maxDepth int) error {
+	if err := maps.CheckJSONDepth(data, maxDepth); err != nil {
+		return err
+	}
+	return m.FromJSON(data)
+}
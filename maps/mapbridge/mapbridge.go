@@ -0,0 +1,28 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package mapbridge converts between map implementations.
+//
+// It is kept apart from the maps it converts, so that none of them has to depend on another.
+package mapbridge
+
+import (
+	"github.com/lemonyxk/gods/maps/hashmap"
+	"github.com/lemonyxk/gods/maps/treemap"
+	"github.com/lemonyxk/gods/utils"
+)
+
+// SortedFrom instantiates a tree map with the custom comparator holding the elements of the hash map.
+// The keys are sorted once and the underlying tree is built balanced in O(n) from the sorted keys,
+// instead of inserting the elements one by one.
+// Panics if comparator is nil or reports two distinct keys of the hash map as equal.
+func SortedFrom[T comparable, P any](m *hashmap.Map[T, P], comparator utils.Comparator) *treemap.Map[T, P] {
+	keys := m.Keys()
+	utils.Sort(keys, comparator)
+	values := make([]P, len(keys))
+	for i, key := range keys {
+		values[i], _ = m.Get(key)
+	}
+	return treemap.NewFromSorted[T, P](comparator, keys, values)
+}
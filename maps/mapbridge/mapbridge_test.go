@@ -0,0 +1,34 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mapbridge
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/lemonyxk/gods/maps/hashmap"
+	"github.com/lemonyxk/gods/utils"
+)
+
+func TestSortedFrom(t *testing.T) {
+	hm := hashmap.New[int, string]()
+	hm.Put(3, "c")
+	hm.Put(1, "a")
+	hm.Put(2, "b")
+	m := SortedFrom(hm, utils.IntComparator)
+	if actualValue, expectedValue := fmt.Sprint(m.Keys()), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(m.Values()), "[a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.Put(0, "z")
+	if actualValue, found := m.Get(0); actualValue != "z" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "z")
+	}
+	if actualValue := SortedFrom(hashmap.New[int, string](), utils.IntComparator).Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
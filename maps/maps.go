@@ -15,11 +15,16 @@
 // Reference: https://en.wikipedia.org/wiki/Associative_array
 package maps
 
-import "github.com/lemonyxk/gods/containers"
+import (
+	"reflect"
+
+	"github.com/lemonyxk/gods/containers"
+)
 
 // Map interface that all maps implement
 type Map[T comparable, P any] interface {
 	Put(key T, value P)
+	PutIfAbsent(key T, value P) (actual P, loaded bool)
 	Get(key T) (value P, found bool)
 	Remove(key T)
 	Keys() []T
@@ -37,3 +42,22 @@ type BidiMap[T comparable, P any] interface {
 
 	Map[T, P]
 }
+
+// Copy puts all key-value pairs of src into dst, overwriting the values of keys already in dst.
+// The keys of src are read up front, so copying a map into itself leaves it unchanged.
+func Copy[T comparable, P any](dst, src Map[T, P]) {
+	for _, key := range src.Keys() {
+		if value, found := src.Get(key); found {
+			dst.Put(key, value)
+		}
+	}
+}
+
+// ValuesEqual reports whether the two values are equal as decided by the equal function,
+// or reflect.DeepEqual if equal is nil.
+func ValuesEqual[P any](a, b P, equal func(a, b P) bool) bool {
+	if equal == nil {
+		return reflect.DeepEqual(a, b)
+	}
+	return equal(a, b)
+}
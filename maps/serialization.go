@@ -0,0 +1,72 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package maps
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// CheckDuplicateJSONKeys returns an error naming the first key that appears more than once in the input JSON object.
+// Only the keys of the top-level object are checked. Input that is not a JSON object is not checked.
+//
+// Unmarshaling into a Go map silently keeps the last of duplicate keys, hence maps use this check for strict deserialization.
+func CheckDuplicateJSONKeys(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return nil
+	}
+	seen := make(map[string]struct{})
+	for decoder.More() {
+		token, err = decoder.Token()
+		if err != nil {
+			return err
+		}
+		key := token.(string)
+		if _, exists := seen[key]; exists {
+			return fmt.Errorf("duplicate key %q", key)
+		}
+		seen[key] = struct{}{}
+		var value json.RawMessage
+		if err = decoder.Decode(&value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CheckJSONDepth returns an error if arrays and objects in the input JSON are nested deeper than maxDepth,
+// where the top-level object counts as depth 1. The input is streamed token by token without recursion,
+// so that maps can reject adversarial input before decoding it.
+func CheckJSONDepth(data []byte, maxDepth int) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	depth := 0
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if delim, ok := token.(json.Delim); ok {
+			switch delim {
+			case '{', '[':
+				depth++
+				if depth > maxDepth {
+					return fmt.Errorf("nesting exceeds maximum depth %d", maxDepth)
+				}
+			case '}', ']':
+				depth--
+			}
+		}
+	}
+}
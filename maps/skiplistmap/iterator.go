@@ -0,0 +1,78 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package skiplistmap
+
+import "github.com/lemonyxk/gods/containers"
+
+func assertIteratorImplementation[T comparable, P any]() {
+	var _ containers.IteratorWithKey[T, P] = (*Iterator[T, P])(nil)
+}
+
+// Iterator holding the iterator's state
+type Iterator[T comparable, P any] struct {
+	m    *Map[T, P]
+	node *node[T, P]
+}
+
+// Iterator returns a stateful iterator whose elements are key/value pairs.
+func (m *Map[T, P]) Iterator() Iterator[T, P] {
+	return Iterator[T, P]{m: m, node: m.head}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the container.
+// If Next() returns true, then next element's key and value can be retrieved by Key() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *Iterator[T, P]) Next() bool {
+	if iterator.node != nil {
+		iterator.node = iterator.node.next[0]
+	}
+	return iterator.node != nil
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[T, P]) Value() P {
+	return iterator.node.value
+}
+
+// Key returns the current element's key.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[T, P]) Key() T {
+	return iterator.node.key
+}
+
+// KeyValue returns the current element's key and value as an entry.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[T, P]) KeyValue() containers.Entry[T, P] {
+	return containers.Entry[T, P]{Key: iterator.Key(), Value: iterator.Value()}
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *Iterator[T, P]) Begin() {
+	iterator.node = iterator.m.head
+}
+
+// Reset resets the iterator to its initial state (one-before-first), same as Begin().
+// Call Next() to fetch the first element if any.
+func (iterator *Iterator[T, P]) Reset() {
+	iterator.Begin()
+}
+
+// Done returns true if the iterator is past the last element (one-past-the-end),
+// e.g. after Next() returned false.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[T, P]) Done() bool {
+	return iterator.node == nil
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the container.
+// If First() returns true, then first element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator[T, P]) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package skiplistmap
+
+import (
+	"encoding/json"
+
+	"github.com/lemonyxk/gods/containers"
+	"github.com/lemonyxk/gods/maps"
+	"github.com/lemonyxk/gods/utils"
+)
+
+func assertSerializationImplementation[T comparable, P any]() {
+	var _ containers.JSONSerializer = (*Map[T, P])(nil)
+	var _ containers.JSONDeserializer = (*Map[T, P])(nil)
+}
+
+// ToJSON outputs the JSON representation of the map.
+func (m *Map[T, P]) ToJSON() ([]byte, error) {
+	elements := make(map[string]interface{})
+	for n := m.head.next[0]; n != nil; n = n.next[0] {
+		key, err := utils.ToJSONKey(n.key)
+		if err != nil {
+			return nil, err
+		}
+		elements[key] = n.value
+	}
+	return json.Marshal(&elements)
+}
+
+// FromJSON populates the map from the input JSON representation.
+func (m *Map[T, P]) FromJSON(data []byte) error {
+	m.checkFrozen()
+	elements := make(map[T]P)
+	err := json.Unmarshal(data, &elements)
+	if err == nil {
+		m.Clear()
+		for key, value := range elements {
+			m.Put(key, value)
+		}
+	}
+	return err
+}
+
+// FromJSONStrict populates the map from the input JSON representation like FromJSON,
+// but returns an error naming the duplicate key if the input contains the same key more than once.
+// The map is left untouched if the input is rejected.
+func (m *Map[T, P]) FromJSONStrict(data []byte) error {
+	if err := maps.CheckDuplicateJSONKeys(data); err != nil {
+		return err
+	}
+	return m.FromJSON(data)
+}
+
+// FromJSONLimited populates the map from the input JSON representation like FromJSON,
+// but returns an error if arrays and objects in the input are nested deeper than maxDepth,
+// e.g. to guard against adversarial input. The map is left untouched if the input is rejected.
+func (m *Map[T, P]) FromJSONLimited(data []byte, maxDepth int) error {
+	if err := maps.CheckJSONDepth(data, maxDepth); err != nil {
+		return err
+	}
+	return m.FromJSON(data)
+}
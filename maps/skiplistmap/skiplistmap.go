@@ -0,0 +1,315 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package skiplistmap implements a map backed by a probabilistic skip list.
+//
+// Elements are ordered by key in the map.
+//
+// Search, insertion and removal run in expected O(log n) time.
+// Level generation is randomized and can be seeded deterministically with NewWithRand.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Skip_list
+package skiplistmap
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/lemonyxk/gods/maps"
+	"github.com/lemonyxk/gods/utils"
+)
+
+func assertMapImplementation[T comparable, P any]() {
+	var _ maps.Map[T, P] = (*Map[T, P])(nil)
+}
+
+const (
+	maxLevel    = 32
+	probability = 0.25
+)
+
+// Map holds the elements in a skip list
+type Map[T comparable, P any] struct {
+	head       *node[T, P]
+	level      int
+	size       int
+	rnd        *rand.Rand
+	frozen     bool
+	Comparator utils.Comparator
+}
+
+type node[T comparable, P any] struct {
+	key   T
+	value P
+	next  []*node[T, P]
+}
+
+// NewWith instantiates a skip list map with the custom comparator.
+// Panics if comparator is nil.
+func NewWith[T comparable, P any](comparator utils.Comparator) *Map[T, P] {
+	return NewWithRand[T, P](comparator, rand.New(rand.NewSource(time.Now().UnixNano())))
+}
+
+// NewWithRand instantiates a skip list map with the custom comparator and the random source used for level generation.
+// Passing a deterministically seeded source makes the structure of the skip list reproducible, e.g. for testing.
+// Panics if comparator is nil.
+func NewWithRand[T comparable, P any](comparator utils.Comparator, rnd *rand.Rand) *Map[T, P] {
+	if comparator == nil {
+		panic("comparator must not be nil")
+	}
+	return &Map[T, P]{
+		head:       &node[T, P]{next: make([]*node[T, P], maxLevel)},
+		level:      1,
+		rnd:        rnd,
+		Comparator: comparator,
+	}
+}
+
+// NewWithIntComparator instantiates a skip list map with the IntComparator, i.e. keys are of type int.
+func NewWithIntComparator[T comparable, P any]() *Map[T, P] {
+	return NewWith[T, P](utils.IntComparator)
+}
+
+// NewWithStringComparator instantiates a skip list map with the StringComparator, i.e. keys are of type string.
+func NewWithStringComparator[T comparable, P any]() *Map[T, P] {
+	return NewWith[T, P](utils.StringComparator)
+}
+
+// Put inserts key-value pair into the map.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[T, P]) Put(key T, value P) {
+	m.put(key, value, true)
+}
+
+// PutIfAbsent inserts key-value pair into the map only if the key is not in the map yet, in a single search.
+// Returns the existing value and true if the key was found, otherwise the inserted value and false.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[T, P]) PutIfAbsent(key T, value P) (actual P, loaded bool) {
+	return m.put(key, value, false)
+}
+
+// put inserts key-value pair into the map, or replaces the key and value of an equal key if overwrite is true.
+// Returns the value held for the key afterwards and whether the key was already in the map.
+func (m *Map[T, P]) put(key T, value P, overwrite bool) (actual P, loaded bool) {
+	var update [maxLevel]*node[T, P]
+	current := m.head
+	for i := m.level - 1; i >= 0; i-- {
+		for current.next[i] != nil && m.Comparator(current.next[i].key, key) < 0 {
+			current = current.next[i]
+		}
+		update[i] = current
+	}
+	if next := current.next[0]; next != nil && m.Comparator(next.key, key) == 0 {
+		if overwrite {
+			m.checkFrozen()
+			next.key = key
+			next.value = value
+		}
+		return next.value, true
+	}
+	m.checkFrozen()
+	level := m.randomLevel()
+	if level > m.level {
+		for i := m.level; i < level; i++ {
+			update[i] = m.head
+		}
+		m.level = level
+	}
+	newNode := &node[T, P]{key: key, value: value, next: make([]*node[T, P], level)}
+	for i := 0; i < level; i++ {
+		newNode.next[i] = update[i].next[i]
+		update[i].next[i] = newNode
+	}
+	m.size++
+	return value, false
+}
+
+// Get searches the element in the map by key and returns its value or nil if key is not found in map.
+// Second return parameter is true if key was found, otherwise false.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[T, P]) Get(key T) (value P, found bool) {
+	if n := m.ceilingNode(key); n != nil && m.Comparator(n.key, key) == 0 {
+		return n.value, true
+	}
+	return utils.AnyEmpty[P](), false
+}
+
+// Remove removes the element from the map by key.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[T, P]) Remove(key T) {
+	m.checkFrozen()
+	var update [maxLevel]*node[T, P]
+	current := m.head
+	for i := m.level - 1; i >= 0; i-- {
+		for current.next[i] != nil && m.Comparator(current.next[i].key, key) < 0 {
+			current = current.next[i]
+		}
+		update[i] = current
+	}
+	target := current.next[0]
+	if target == nil || m.Comparator(target.key, key) != 0 {
+		return
+	}
+	for i := 0; i < len(target.next); i++ {
+		update[i].next[i] = target.next[i]
+	}
+	for m.level > 1 && m.head.next[m.level-1] == nil {
+		m.level--
+	}
+	m.size--
+}
+
+// Empty returns true if map does not contain any elements
+func (m *Map[T, P]) Empty() bool {
+	return m.size == 0
+}
+
+// Size returns number of elements in the map.
+func (m *Map[T, P]) Size() int {
+	return m.size
+}
+
+// Keys returns all keys in-order
+func (m *Map[T, P]) Keys() []T {
+	keys := make([]T, 0, m.size)
+	for n := m.head.next[0]; n != nil; n = n.next[0] {
+		keys = append(keys, n.key)
+	}
+	return keys
+}
+
+// Values returns all values in-order based on the key.
+func (m *Map[T, P]) Values() []P {
+	values := make([]P, 0, m.size)
+	for n := m.head.next[0]; n != nil; n = n.next[0] {
+		values = append(values, n.value)
+	}
+	return values
+}
+
+// Clear removes all elements from the map.
+func (m *Map[T, P]) Clear() {
+	m.checkFrozen()
+	m.head = &node[T, P]{next: make([]*node[T, P], maxLevel)}
+	m.level = 1
+	m.size = 0
+}
+
+// Min returns the minimum key and its value from the map.
+// Returns nil, nil if map is empty.
+func (m *Map[T, P]) Min() (key T, value P) {
+	if n := m.head.next[0]; n != nil {
+		return n.key, n.value
+	}
+	return utils.AnyEmpty[T](), utils.AnyEmpty[P]()
+}
+
+// Max returns the maximum key and its value from the map.
+// Returns nil, nil if map is empty.
+func (m *Map[T, P]) Max() (key T, value P) {
+	current := m.head
+	for i := m.level - 1; i >= 0; i-- {
+		for current.next[i] != nil {
+			current = current.next[i]
+		}
+	}
+	if current != m.head {
+		return current.key, current.value
+	}
+	return utils.AnyEmpty[T](), utils.AnyEmpty[P]()
+}
+
+// Floor finds the floor key-value pair for the input key.
+// In case that no floor is found, then both returned values will be nil.
+//
+// Floor key is defined as the largest key that is smaller than or equal to the given key.
+// A floor key may not be found, either because the map is empty, or because
+// all keys in the map are larger than the given key.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[T, P]) Floor(key T) (foundKey T, foundValue P) {
+	current := m.head
+	for i := m.level - 1; i >= 0; i-- {
+		for current.next[i] != nil && m.Comparator(current.next[i].key, key) <= 0 {
+			current = current.next[i]
+		}
+	}
+	if current != m.head {
+		return current.key, current.value
+	}
+	return utils.AnyEmpty[T](), utils.AnyEmpty[P]()
+}
+
+// Ceiling finds the ceiling key-value pair for the input key.
+// In case that no ceiling is found, then both returned values will be nil.
+//
+// Ceiling key is defined as the smallest key that is larger than or equal to the given key.
+// A ceiling key may not be found, either because the map is empty, or because
+// all keys in the map are smaller than the given key.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[T, P]) Ceiling(key T) (foundKey T, foundValue P) {
+	if n := m.ceilingNode(key); n != nil {
+		return n.key, n.value
+	}
+	return utils.AnyEmpty[T](), utils.AnyEmpty[P]()
+}
+
+// Range calls the given function once for each element whose key is within [from, to), in-order.
+// Keys should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[T, P]) Range(from T, to T, f func(key T, value P)) {
+	for n := m.ceilingNode(from); n != nil && m.Comparator(n.key, to) < 0; n = n.next[0] {
+		f(n.key, n.value)
+	}
+}
+
+// Freeze makes the map immutable; later modifying calls panic with "container is frozen".
+// Reads remain allowed. Freezing is one-way.
+func (m *Map[T, P]) Freeze() {
+	m.frozen = true
+}
+
+// Frozen returns true if the map has been frozen.
+func (m *Map[T, P]) Frozen() bool {
+	return m.frozen
+}
+
+// String returns a string representation of container
+func (m *Map[T, P]) String() string {
+	str := "SkipListMap\nmap["
+	for n := m.head.next[0]; n != nil; n = n.next[0] {
+		str += fmt.Sprintf("%v:%v ", n.key, n.value)
+	}
+	return strings.TrimRight(str, " ") + "]"
+}
+
+// ceilingNode returns the first node whose key is larger than or equal to the given key or nil if there is none.
+func (m *Map[T, P]) ceilingNode(key T) *node[T, P] {
+	current := m.head
+	for i := m.level - 1; i >= 0; i-- {
+		for current.next[i] != nil && m.Comparator(current.next[i].key, key) < 0 {
+			current = current.next[i]
+		}
+	}
+	return current.next[0]
+}
+
+func (m *Map[T, P]) randomLevel() int {
+	level := 1
+	for level < maxLevel && m.rnd.Float64() < probability {
+		level++
+	}
+	return level
+}
+
+// checkFrozen panics if the map has been frozen.
+func (m *Map[T, P]) checkFrozen() {
+	if m.frozen {
+		panic("container is frozen")
+	}
+}
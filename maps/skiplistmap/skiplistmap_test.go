@@ -0,0 +1,393 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package skiplistmap
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/lemonyxk/gods/utils"
+)
+
+func TestMapPut(t *testing.T) {
+	m := NewWithIntComparator[int, string]()
+	m.Put(5, "e")
+	m.Put(6, "f")
+	m.Put(7, "g")
+	m.Put(3, "c")
+	m.Put(4, "d")
+	m.Put(1, "x")
+	m.Put(2, "b")
+	m.Put(1, "a") // overwrite
+
+	if actualValue := m.Size(); actualValue != 7 {
+		t.Errorf("Got %v expected %v", actualValue, 7)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[1 2 3 4 5 6 7]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Values()), "[a b c d e f g]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// key,expectedValue,expectedFound
+	tests1 := [][]interface{}{
+		{1, "a", true},
+		{2, "b", true},
+		{3, "c", true},
+		{4, "d", true},
+		{5, "e", true},
+		{6, "f", true},
+		{7, "g", true},
+		{8, "", false},
+	}
+
+	for _, test := range tests1 {
+		// retrievals
+		actualValue, actualFound := m.Get(test[0].(int))
+		if actualValue != test[1] || actualFound != test[2] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+	}
+}
+
+func TestMapPutIfAbsent(t *testing.T) {
+	m := NewWithIntComparator[int, string]()
+	m.Put(1, "a")
+
+	if actualValue, loaded := m.PutIfAbsent(1, "x"); actualValue != "a" || !loaded {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, loaded, "a", true)
+	}
+	if actualValue, loaded := m.PutIfAbsent(2, "b"); actualValue != "b" || loaded {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, loaded, "b", false)
+	}
+	if actualValue, found := m.Get(1); actualValue != "a" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+	if actualValue := m.Size(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+}
+
+func TestMapRemove(t *testing.T) {
+	m := NewWithIntComparator[int, string]()
+	m.Put(5, "e")
+	m.Put(6, "f")
+	m.Put(7, "g")
+	m.Put(3, "c")
+	m.Put(4, "d")
+	m.Put(1, "x")
+	m.Put(2, "b")
+	m.Put(1, "a") // overwrite
+
+	m.Remove(5)
+	m.Remove(6)
+	m.Remove(7)
+	m.Remove(8)
+	m.Remove(5)
+
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[1 2 3 4]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := m.Size(); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	if _, found := m.Get(5); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+
+	m.Remove(1)
+	m.Remove(4)
+	m.Remove(2)
+	m.Remove(3)
+	m.Remove(2)
+
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := m.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := m.level; actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+}
+
+func TestMapMinMax(t *testing.T) {
+	m := NewWithIntComparator[int, string]()
+	if key, value := m.Min(); key != 0 || value != "" {
+		t.Errorf("Got %v->%v expected %v->%v", key, value, 0, "")
+	}
+	if key, value := m.Max(); key != 0 || value != "" {
+		t.Errorf("Got %v->%v expected %v->%v", key, value, 0, "")
+	}
+	m.Put(5, "e")
+	m.Put(1, "a")
+	m.Put(9, "i")
+	if key, value := m.Min(); key != 1 || value != "a" {
+		t.Errorf("Got %v->%v expected %v->%v", key, value, 1, "a")
+	}
+	if key, value := m.Max(); key != 9 || value != "i" {
+		t.Errorf("Got %v->%v expected %v->%v", key, value, 9, "i")
+	}
+}
+
+func TestMapFloorCeiling(t *testing.T) {
+	m := NewWithIntComparator[int, string]()
+	m.Put(7, "g")
+	m.Put(3, "c")
+	m.Put(1, "a")
+
+	// key,expectedFloorKey,expectedCeilingKey
+	tests := [][]interface{}{
+		{-1, 0, 1},
+		{0, 0, 1},
+		{1, 1, 1},
+		{2, 1, 3},
+		{3, 3, 3},
+		{4, 3, 7},
+		{7, 7, 7},
+		{8, 7, 0},
+	}
+
+	for _, test := range tests {
+		if actualKey, _ := m.Floor(test[0].(int)); actualKey != test[1] {
+			t.Errorf("Got %v expected %v", actualKey, test[1])
+		}
+		if actualKey, _ := m.Ceiling(test[0].(int)); actualKey != test[2] {
+			t.Errorf("Got %v expected %v", actualKey, test[2])
+		}
+	}
+}
+
+func TestMapRange(t *testing.T) {
+	m := NewWithIntComparator[int, string]()
+	for i, value := range []string{"a", "b", "c", "d", "e", "f"} {
+		m.Put((i+1)*10, value)
+	}
+
+	tests := [][]interface{}{
+		{20, 50, "[20:b 30:c 40:d]"},
+		{15, 45, "[20:b 30:c 40:d]"},
+		{0, 100, "[10:a 20:b 30:c 40:d 50:e 60:f]"},
+		{30, 30, "[]"},
+		{50, 20, "[]"},
+		{70, 80, "[]"},
+	}
+
+	for _, test := range tests {
+		entries := []string{}
+		m.Range(test[0].(int), test[1].(int), func(key int, value string) {
+			entries = append(entries, fmt.Sprintf("%v:%v", key, value))
+		})
+		if actualValue, expectedValue := fmt.Sprintf("%v", entries), test[2]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestMapDeterministicRand(t *testing.T) {
+	m1 := NewWithRand[int, int](utils.IntComparator, rand.New(rand.NewSource(42)))
+	m2 := NewWithRand[int, int](utils.IntComparator, rand.New(rand.NewSource(42)))
+	for i := 0; i < 1000; i++ {
+		m1.Put(i, i)
+		m2.Put(i, i)
+	}
+	for n1, n2 := m1.head.next[0], m2.head.next[0]; n1 != nil; n1, n2 = n1.next[0], n2.next[0] {
+		if len(n1.next) != len(n2.next) {
+			t.Errorf("Got %v expected %v", len(n1.next), len(n2.next))
+		}
+	}
+}
+
+func TestMapRandom(t *testing.T) {
+	m := NewWithRand[int, int](utils.IntComparator, rand.New(rand.NewSource(3)))
+	native := make(map[int]int)
+	r := rand.New(rand.NewSource(7))
+	for i := 0; i < 10000; i++ {
+		key := r.Intn(500)
+		if r.Intn(3) == 0 {
+			m.Remove(key)
+			delete(native, key)
+		} else {
+			m.Put(key, i)
+			native[key] = i
+		}
+	}
+	if actualValue, expectedValue := m.Size(), len(native); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	keys := m.Keys()
+	for i := 1; i < len(keys); i++ {
+		if keys[i-1] >= keys[i] {
+			t.Errorf("Not sorted!")
+		}
+	}
+	for key, expectedValue := range native {
+		if actualValue, found := m.Get(key); actualValue != expectedValue || !found {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestMapIterator(t *testing.T) {
+	m := NewWithStringComparator[string, int]()
+	it := m.Iterator()
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on empty map")
+	}
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	it = m.Iterator()
+	count := 0
+	for it.Next() {
+		count++
+		if actualValue, expectedValue := it.Key(), string(rune('a'+count-1)); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := it.Value(), count; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := it.Next(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := it.First(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if key, value := it.Key(), it.Value(); key != "a" || value != 1 {
+		t.Errorf("Got %v,%v expected %v,%v", key, value, "a", 1)
+	}
+	if actualValue := it.KeyValue(); actualValue.Key != "a" || actualValue.Value != 1 {
+		t.Errorf("Got %v expected %v", actualValue, "{a 1}")
+	}
+}
+
+func TestMapIteratorResetDone(t *testing.T) {
+	m := NewWithIntComparator[int, string]()
+	m.Put(3, "c")
+	m.Put(1, "a")
+	m.Put(2, "b")
+	it := m.Iterator()
+	if actualValue := it.Done(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	it.Next()
+	it.Next()
+	if actualValue := it.Done(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	for it.Next() {
+	}
+	if actualValue := it.Done(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	it.Reset()
+	if actualValue := it.Done(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if !it.Next() || it.Key() != 1 {
+		t.Errorf("Got %v expected %v", it.Key(), 1)
+	}
+}
+
+func TestMapSerialization(t *testing.T) {
+	m := NewWithStringComparator[string, float64]()
+	m.Put("a", 1.0)
+	m.Put("b", 2.0)
+	m.Put("c", 3.0)
+
+	var err error
+	assert := func() {
+		if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[a b c]"; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := fmt.Sprintf("%v", m.Values()), "[1 2 3]"; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if err != nil {
+			t.Errorf("Got error %v", err)
+		}
+	}
+
+	assert()
+
+	json, err := m.ToJSON()
+	assert()
+
+	err = m.FromJSON(json)
+	assert()
+}
+
+func benchmarkGet(b *testing.B, m *Map[int, struct{}], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Get(n)
+		}
+	}
+}
+
+func benchmarkPut(b *testing.B, m *Map[int, struct{}], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Put(n, struct{}{})
+		}
+	}
+}
+
+func TestMapFreeze(t *testing.T) {
+	m := NewWithIntComparator[int, string]()
+	m.Put(1, "a")
+	m.Freeze()
+	if actualValue := m.Frozen(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	assertFrozenPanic(t, func() { m.Put(1, "b") })
+	assertFrozenPanic(t, func() { m.PutIfAbsent(2, "b") })
+	assertFrozenPanic(t, func() { m.Remove(1) })
+	assertFrozenPanic(t, func() { m.Clear() })
+	assertFrozenPanic(t, func() { m.FromJSON([]byte(`{"2":"b"}`)) })
+	if actualValue, loaded := m.PutIfAbsent(1, "b"); actualValue != "a" || !loaded {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+	if actualValue, found := m.Get(1); actualValue != "a" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+}
+
+// assertFrozenPanic asserts that f panics because the container is frozen.
+func assertFrozenPanic(t *testing.T, f func()) {
+	defer func() {
+		if r := recover(); r != "container is frozen" {
+			t.Errorf("Got %v expected %v", r, "container is frozen")
+		}
+	}()
+	f()
+}
+
+func BenchmarkSkipListMapGet10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := NewWithIntComparator[int, struct{}]()
+	for n := 0; n < size; n++ {
+		m.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkGet(b, m, size)
+}
+
+func BenchmarkSkipListMapPut10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := NewWithIntComparator[int, struct{}]()
+	b.StartTimer()
+	benchmarkPut(b, m, size)
+}
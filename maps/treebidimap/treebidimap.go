@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/lemonyxk/gods/containers"
 	"github.com/lemonyxk/gods/maps"
 	"github.com/lemonyxk/gods/trees/redblacktree"
 	"github.com/lemonyxk/gods/utils"
@@ -36,6 +37,7 @@ type Map[T comparable, P comparable] struct {
 	inverseMap      redblacktree.Tree[P, T]
 	keyComparator   utils.Comparator
 	valueComparator utils.Comparator
+	frozen          bool
 }
 
 // NewWith instantiates a bidirectional map.
@@ -59,7 +61,22 @@ func NewWithStringComparators[T comparable, P comparable]() *Map[T, P] {
 }
 
 // Put inserts element into the map.
+// If the value is already mapped to another key, that key is removed from the map.
 func (m *Map[T, P]) Put(key T, value P) {
+	m.PutWith(key, value, nil)
+}
+
+// PutWith inserts element into the map like Put, but if the value is already mapped to another key,
+// the given function is called with that key first and the element is only inserted if it returns true,
+// in which case the other key is removed from the map. Otherwise the map is left unchanged.
+// A nil function allows every displacement.
+func (m *Map[T, P]) PutWith(key T, value P, onValueConflict func(existingKey T) bool) {
+	m.checkFrozen()
+	if k, ok := m.inverseMap.Get(value); ok && onValueConflict != nil && m.keyComparator(k, key) != 0 {
+		if !onValueConflict(k) {
+			return
+		}
+	}
 	if v, ok := m.forwardMap.Get(key); ok {
 		m.inverseMap.Remove(v)
 	}
@@ -71,6 +88,17 @@ func (m *Map[T, P]) Put(key T, value P) {
 	m.inverseMap.Put(value, key)
 }
 
+// PutIfAbsent inserts element into the map only if the key is not in the map yet.
+// Returns the existing value and true if the key was found, otherwise the inserted value and false.
+// If the value is already mapped to another key, that key is removed from the map, as with Put.
+func (m *Map[T, P]) PutIfAbsent(key T, value P) (actual P, loaded bool) {
+	if actual, loaded = m.forwardMap.Get(key); loaded {
+		return actual, true
+	}
+	m.Put(key, value)
+	return value, false
+}
+
 // Get searches the element in the map by key and returns its value or nil if key is not found in map.
 // Second return parameter is true if key was found, otherwise false.
 func (m *Map[T, P]) Get(key T) (value P, found bool) {
@@ -91,6 +119,7 @@ func (m *Map[T, P]) GetKey(value P) (key T, found bool) {
 
 // Remove removes the element from the map by key.
 func (m *Map[T, P]) Remove(key T) {
+	m.checkFrozen()
 	if d, found := m.forwardMap.Get(key); found {
 		m.forwardMap.Remove(key)
 		m.inverseMap.Remove(d)
@@ -117,12 +146,44 @@ func (m *Map[T, P]) Values() []P {
 	return m.inverseMap.Keys()
 }
 
+// EntriesByKey returns all key-value pairs ordered by key.
+func (m *Map[T, P]) EntriesByKey() []containers.Entry[T, P] {
+	entries := make([]containers.Entry[T, P], m.Size())
+	it := m.forwardMap.Iterator()
+	for i := 0; it.Next(); i++ {
+		entries[i] = it.KeyValue()
+	}
+	return entries
+}
+
+// EntriesByValue returns all key-value pairs ordered by value.
+func (m *Map[T, P]) EntriesByValue() []containers.Entry[T, P] {
+	entries := make([]containers.Entry[T, P], m.Size())
+	it := m.inverseMap.Iterator()
+	for i := 0; it.Next(); i++ {
+		entries[i] = containers.Entry[T, P]{Key: it.Value(), Value: it.Key()}
+	}
+	return entries
+}
+
 // Clear removes all elements from the map.
 func (m *Map[T, P]) Clear() {
+	m.checkFrozen()
 	m.forwardMap.Clear()
 	m.inverseMap.Clear()
 }
 
+// Freeze makes the map immutable; later modifying calls panic with "container is frozen".
+// Reads remain allowed. Freezing is one-way.
+func (m *Map[T, P]) Freeze() {
+	m.frozen = true
+}
+
+// Frozen returns true if the map has been frozen.
+func (m *Map[T, P]) Frozen() bool {
+	return m.frozen
+}
+
 // String returns a string representation of container
 func (m *Map[T, P]) String() string {
 	str := "TreeBidiMap\nmap["
@@ -132,3 +193,10 @@ func (m *Map[T, P]) String() string {
 	}
 	return strings.TrimRight(str, " ") + "]"
 }
+
+// checkFrozen panics if the map has been frozen.
+func (m *Map[T, P]) checkFrozen() {
+	if m.frozen {
+		panic("container is frozen")
+	}
+}
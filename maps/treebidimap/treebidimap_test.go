@@ -53,6 +53,68 @@ func TestMapPut(t *testing.T) {
 	}
 }
 
+func TestMapPutWith(t *testing.T) {
+	m := NewWith[int, string](utils.IntComparator, utils.StringComparator)
+	m.Put(1, "a")
+	m.Put(2, "b")
+
+	conflicts := []int{}
+	reject := func(existingKey int) bool {
+		conflicts = append(conflicts, existingKey)
+		return false
+	}
+	m.PutWith(3, "a", reject)
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[1 2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, found := m.GetKey("a"); actualValue != 1 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+
+	m.PutWith(1, "a", reject) // same pair, no conflict
+	m.PutWith(4, "d", reject) // new value, no conflict
+	if actualValue, expectedValue := fmt.Sprintf("%v", conflicts), "[1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	m.PutWith(3, "b", func(existingKey int) bool {
+		return true
+	})
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[1 3 4]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, found := m.GetKey("b"); actualValue != 3 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+}
+
+func TestMapPutIfAbsent(t *testing.T) {
+	m := NewWith[int, string](utils.IntComparator, utils.StringComparator)
+	m.Put(1, "a")
+	m.Put(2, "b")
+
+	if actualValue, loaded := m.PutIfAbsent(1, "x"); actualValue != "a" || !loaded {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, loaded, "a", true)
+	}
+	if actualValue, found := m.GetKey("x"); found {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+
+	// the value is already mapped to another key, which is removed
+	if actualValue, loaded := m.PutIfAbsent(3, "b"); actualValue != "b" || loaded {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, loaded, "b", false)
+	}
+	if actualValue, found := m.Get(2); found {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue, found := m.GetKey("b"); actualValue != 3 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, expectedValue := fmt.Sprint(m.Keys()), "[1 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func TestMapRemove(t *testing.T) {
 	m := NewWith[int, string](utils.IntComparator, utils.StringComparator)
 	m.Put(5, "e")
@@ -171,6 +233,23 @@ func sameElements[T comparable](a []T, b []T) bool {
 	return true
 }
 
+func TestMapEntriesByKeyValue(t *testing.T) {
+	m := NewWith[int, string](utils.IntComparator, utils.StringComparator)
+	if actualValue := m.EntriesByKey(); len(actualValue) != 0 {
+		t.Errorf("Got %v expected %v", actualValue, "[]")
+	}
+	m.Put(1, "c")
+	m.Put(2, "a")
+	m.Put(3, "b")
+
+	if actualValue, expectedValue := fmt.Sprint(m.EntriesByKey()), "[{1 c} {2 a} {3 b}]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(m.EntriesByValue()), "[{2 a} {3 b} {1 c}]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func TestMapEach(t *testing.T) {
 	m := NewWith[string, int](utils.StringComparator, utils.IntComparator)
 	m.Put("c", 3)
@@ -417,6 +496,40 @@ func TestMapIteratorPrev(t *testing.T) {
 	}
 }
 
+func TestMapIteratorResetDone(t *testing.T) {
+	m := NewWith[int, string](utils.IntComparator, utils.StringComparator)
+	m.Put(3, "c")
+	m.Put(1, "a")
+	m.Put(2, "b")
+	it := m.Iterator()
+	if actualValue := it.Done(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	it.Next()
+	it.Next()
+	if actualValue := it.Done(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	for it.Next() {
+	}
+	if actualValue := it.Done(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	it.Reset()
+	if actualValue := it.Done(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if !it.Next() || it.Key() != 1 {
+		t.Errorf("Got %v expected %v", it.Key(), 1)
+	}
+
+	it.Reset()
+	it.End()
+	if actualValue := it.Done(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
 func TestMapIteratorBegin(t *testing.T) {
 	m := NewWith[int, string](utils.IntComparator, utils.StringComparator)
 	it := m.Iterator()
@@ -547,6 +660,35 @@ func benchmarkRemove(b *testing.B, m *Map[int, int], size int) {
 	}
 }
 
+func TestMapFreeze(t *testing.T) {
+	m := NewWith[int, string](utils.IntComparator, utils.StringComparator)
+	m.Put(1, "a")
+	m.Freeze()
+	if actualValue := m.Frozen(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	assertFrozenPanic(t, func() { m.Put(2, "b") })
+	assertFrozenPanic(t, func() { m.Remove(1) })
+	assertFrozenPanic(t, func() { m.Clear() })
+	assertFrozenPanic(t, func() { m.FromJSON([]byte(`{"2":"b"}`)) })
+	if actualValue, loaded := m.PutIfAbsent(1, "b"); actualValue != "a" || !loaded {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+	if actualValue, found := m.Get(1); actualValue != "a" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+}
+
+// assertFrozenPanic asserts that f panics because the container is frozen.
+func assertFrozenPanic(t *testing.T, f func()) {
+	defer func() {
+		if r := recover(); r != "container is frozen" {
+			t.Errorf("Got %v expected %v", r, "container is frozen")
+		}
+	}()
+	f()
+}
+
 func BenchmarkTreeBidiMapGet100(b *testing.B) {
 	b.StopTimer()
 	size := 100
@@ -0,0 +1,174 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package treemap
+
+import (
+	"github.com/lemonyxk/gods/containers"
+	rbt "github.com/lemonyxk/gods/trees/redblacktree"
+)
+
+// Cursor is a navigable position within the map that does not expose the underlying tree nodes.
+//
+// A cursor is positioned on an element when created and can be moved in either direction.
+// Modifying the map invalidates the cursor.
+type Cursor[T comparable, P any] struct {
+	iterator rbt.Iterator[T, P]
+}
+
+// CursorAt returns a cursor positioned on the element with the given key.
+// Second return parameter is true if key was found, otherwise false and the cursor is nil.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[T, P]) CursorAt(key T) (*Cursor[T, P], bool) {
+	node := m.tree.GetNode(key)
+	if node == nil {
+		return nil, false
+	}
+	return &Cursor[T, P]{iterator: m.tree.IteratorAt(node)}, true
+}
+
+// Next moves the cursor to the next element and returns true if there was a next element in the map.
+// If Next() returns true, then next element's key and value can be retrieved by Key() and Value().
+func (cursor *Cursor[T, P]) Next() bool {
+	return cursor.iterator.Next()
+}
+
+// Prev moves the cursor to the previous element and returns true if there was a previous element in the map.
+// If Prev() returns true, then previous element's key and value can be retrieved by Key() and Value().
+func (cursor *Cursor[T, P]) Prev() bool {
+	return cursor.iterator.Prev()
+}
+
+// Key returns the current element's key.
+// Does not modify the position of the cursor.
+func (cursor *Cursor[T, P]) Key() T {
+	return cursor.iterator.Key()
+}
+
+// Value returns the current element's value.
+// Does not modify the position of the cursor.
+func (cursor *Cursor[T, P]) Value() P {
+	return cursor.iterator.Value()
+}
+
+// LowerBound returns a cursor positioned on the first element whose key is not less than the given key,
+// i.e. on the ceiling element.
+// Second return parameter is true if such an element was found, otherwise false and the cursor is nil.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[T, P]) LowerBound(key T) (*Cursor[T, P], bool) {
+	node, found := m.tree.Ceiling(key)
+	if !found {
+		return nil, false
+	}
+	return &Cursor[T, P]{iterator: m.tree.IteratorAt(node)}, true
+}
+
+// UpperBound returns a cursor positioned on the first element whose key is greater than the given key.
+// Second return parameter is true if such an element was found, otherwise false and the cursor is nil.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[T, P]) UpperBound(key T) (*Cursor[T, P], bool) {
+	node, found := m.tree.Ceiling(key)
+	if !found {
+		return nil, false
+	}
+	cursor := &Cursor[T, P]{iterator: m.tree.IteratorAt(node)}
+	if m.tree.Comparator(node.Key, key) == 0 && !cursor.Next() {
+		return nil, false
+	}
+	return cursor, true
+}
+
+// NextN returns up to n key-value pairs whose keys are strictly greater than the given key, in ascending key order,
+// e.g. for keyset pagination forward from the last key of the previous page.
+// The key itself does not need to be in the map.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[T, P]) NextN(key T, n int) []containers.Entry[T, P] {
+	entries := []containers.Entry[T, P]{}
+	node, found := m.tree.Ceiling(key)
+	if !found || n <= 0 {
+		return entries
+	}
+	it := m.tree.IteratorAt(node)
+	if m.tree.Comparator(node.Key, key) == 0 && !it.Next() {
+		return entries
+	}
+	for ok := true; ok && len(entries) < n; ok = it.Next() {
+		entries = append(entries, it.KeyValue())
+	}
+	return entries
+}
+
+// PrevN returns up to n key-value pairs whose keys are strictly less than the given key, in descending key order,
+// i.e. the closest key first, e.g. for keyset pagination backward from the first key of the next page.
+// The key itself does not need to be in the map.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[T, P]) PrevN(key T, n int) []containers.Entry[T, P] {
+	entries := []containers.Entry[T, P]{}
+	node, found := m.tree.Floor(key)
+	if !found || n <= 0 {
+		return entries
+	}
+	it := m.tree.IteratorAt(node)
+	if m.tree.Comparator(node.Key, key) == 0 && !it.Prev() {
+		return entries
+	}
+	for ok := true; ok && len(entries) < n; ok = it.Prev() {
+		entries = append(entries, it.KeyValue())
+	}
+	return entries
+}
+
+// Range returns up to count key-value pairs starting at the given key and moving in the given direction,
+// i.e. from the ceiling of the key in ascending key order, or from the floor of the key in descending key order.
+// Unlike NextN and PrevN the key itself is included if it is in the map.
+// Returns fewer pairs if the map runs out of elements in that direction.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[T, P]) Range(start T, count int, ascending bool) []containers.Entry[T, P] {
+	entries := []containers.Entry[T, P]{}
+	var node *rbt.Node[T, P]
+	var found bool
+	if ascending {
+		node, found = m.tree.Ceiling(start)
+	} else {
+		node, found = m.tree.Floor(start)
+	}
+	if !found || count <= 0 {
+		return entries
+	}
+	it := m.tree.IteratorAt(node)
+	for ok := true; ok && len(entries) < count; {
+		entries = append(entries, it.KeyValue())
+		if ascending {
+			ok = it.Next()
+		} else {
+			ok = it.Prev()
+		}
+	}
+	return entries
+}
+
+// EachBetween calls the given function once for each element whose key lies between lo and hi in ascending key order,
+// until it returns false. Each bound is included if its flag is true, e.g. [lo, hi) for loInclusive and not hiInclusive.
+// Starts at the ceiling of lo and stops at the first key beyond hi, so that elements outside the range are not visited.
+// Neither bound needs to be in the map. Does not call the function if lo is greater than hi.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[T, P]) EachBetween(lo, hi T, loInclusive, hiInclusive bool, f func(key T, value P) bool) {
+	node, found := m.tree.Ceiling(lo)
+	if !found {
+		return
+	}
+	it := m.tree.IteratorAt(node)
+	if !loInclusive && m.tree.Comparator(node.Key, lo) == 0 && !it.Next() {
+		return
+	}
+	for ok := true; ok; ok = it.Next() {
+		compare := m.tree.Comparator(it.Key(), hi)
+		if compare > 0 || compare == 0 && !hiInclusive {
+			return
+		}
+		if !f(it.Key(), it.Value()) {
+			return
+		}
+	}
+}
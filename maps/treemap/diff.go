@@ -0,0 +1,47 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package treemap
+
+import (
+	"github.com/lemonyxk/gods/containers"
+	"github.com/lemonyxk/gods/maps"
+)
+
+// Diff compares two maps ordered by the same comparator and returns the key-value pairs
+// that were added to (only in new), removed from (only in old) and changed in (values differ) the new map,
+// each in-order based on the key. Changed pairs hold the value of the new map.
+// Values are compared with the valueEqual function or reflect.DeepEqual if valueEqual is nil.
+//
+// Both maps are walked once side by side, i.e. in O(n+m) comparisons.
+func Diff[T comparable, P any](old, new *Map[T, P], valueEqual func(a, b P) bool) (added, removed, changed []containers.Entry[T, P]) {
+	comparator := old.tree.Comparator
+	oldIt, newIt := old.Iterator(), new.Iterator()
+	oldOk, newOk := oldIt.Next(), newIt.Next()
+	for oldOk || newOk {
+		compare := 0
+		switch {
+		case !newOk:
+			compare = -1
+		case !oldOk:
+			compare = 1
+		default:
+			compare = comparator(oldIt.Key(), newIt.Key())
+		}
+		switch {
+		case compare < 0:
+			removed = append(removed, oldIt.KeyValue())
+			oldOk = oldIt.Next()
+		case compare > 0:
+			added = append(added, newIt.KeyValue())
+			newOk = newIt.Next()
+		default:
+			if !maps.ValuesEqual(oldIt.Value(), newIt.Value(), valueEqual) {
+				changed = append(changed, newIt.KeyValue())
+			}
+			oldOk, newOk = oldIt.Next(), newIt.Next()
+		}
+	}
+	return added, removed, changed
+}
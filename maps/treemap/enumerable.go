@@ -5,6 +5,8 @@
 package treemap
 
 import (
+	"math"
+
 	"github.com/lemonyxk/gods/containers"
 	rbt "github.com/lemonyxk/gods/trees/redblacktree"
 	"github.com/lemonyxk/gods/utils"
@@ -22,6 +24,27 @@ func (m *Map[T, P]) Each(f func(key T, value P)) {
 	}
 }
 
+// EachBatch calls the given function with consecutive batches of up to size elements in ascending key order,
+// e.g. for batched writes, stopping early if the function returns false. The final batch may be smaller.
+// Only one batch is held at a time instead of all elements. A size that is not positive is treated as 1.
+func (m *Map[T, P]) EachBatch(size int, f func(batch []containers.Entry[T, P]) bool) {
+	size = max(size, 1)
+	batch := make([]containers.Entry[T, P], 0, min(size, m.Size()))
+	iterator := m.Iterator()
+	for iterator.Next() {
+		batch = append(batch, iterator.KeyValue())
+		if len(batch) == size {
+			if !f(batch) {
+				return
+			}
+			batch = make([]containers.Entry[T, P], 0, min(size, m.Size()))
+		}
+	}
+	if len(batch) > 0 {
+		f(batch)
+	}
+}
+
 // Map invokes the given function once for each element and returns a container
 // containing the values returned by the given function as key/value pairs.
 func (m *Map[T, P]) Map(f func(key1 T, value1 P) (T, P)) *Map[T, P] {
@@ -82,3 +105,48 @@ func (m *Map[T, P]) Find(f func(key T, value P) bool) (T, P) {
 	}
 	return utils.AnyEmpty[T](), utils.AnyEmpty[P]()
 }
+
+// MinBy passes each element of the container to the given function and returns the
+// (key,value) of the element with the smallest score, the first one in key order in case of ties.
+// Unlike Min, the ranking is by the given function and not by the key ordering.
+// Third return parameter is true, unless the map was empty.
+func (m *Map[T, P]) MinBy(score func(key T, value P) float64) (key T, value P, ok bool) {
+	return m.extremeBy(score, func(a, b float64) bool { return a < b })
+}
+
+// MaxBy passes each element of the container to the given function and returns the
+// (key,value) of the element with the largest score, the first one in key order in case of ties.
+// Unlike Max, the ranking is by the given function and not by the key ordering.
+// Third return parameter is true, unless the map was empty.
+func (m *Map[T, P]) MaxBy(score func(key T, value P) float64) (key T, value P, ok bool) {
+	return m.extremeBy(score, func(a, b float64) bool { return a > b })
+}
+
+func (m *Map[T, P]) extremeBy(score func(key T, value P) float64, better func(a, b float64) bool) (key T, value P, ok bool) {
+	var best float64
+	iterator := m.Iterator()
+	for iterator.Next() {
+		if s := score(iterator.Key(), iterator.Value()); !ok || better(s, best) {
+			key, value, best, ok = iterator.Key(), iterator.Value(), s, true
+		}
+	}
+	return key, value, ok
+}
+
+// Histogram aggregates the elements of the map into fixed-width buckets in a single in-order pass.
+// The extract function maps each element to a numeric position and the weight added to the position's bucket,
+// e.g. the key and the value of a map with numeric keys and values.
+// Buckets are keyed by their lower bound, i.e. position x falls into the bucket floor(x/bucketWidth)*bucketWidth.
+// Panics if bucketWidth is not positive.
+func Histogram[T comparable, P any](m *Map[T, P], bucketWidth float64, extract func(key T, value P) (x float64, weight float64)) map[float64]float64 {
+	if !(bucketWidth > 0) {
+		panic("bucket width must be positive")
+	}
+	buckets := make(map[float64]float64)
+	iterator := m.Iterator()
+	for iterator.Next() {
+		x, weight := extract(iterator.Key(), iterator.Value())
+		buckets[math.Floor(x/bucketWidth)*bucketWidth] += weight
+	}
+	return buckets
+}
@@ -5,6 +5,8 @@
 package treemap
 
 import (
+	"iter"
+
 	"github.com/lemonyxk/gods/containers"
 	rbt "github.com/lemonyxk/gods/trees/redblacktree"
 )
@@ -23,6 +25,32 @@ func (m *Map[T, P]) Iterator() Iterator[T, P] {
 	return Iterator[T, P]{iterator: m.tree.Iterator()}
 }
 
+// KeysSeq returns a lazy iterator over all keys in-order, e.g. for key := range m.KeysSeq() {...}.
+// Unlike Keys(), no slice of keys is allocated.
+func (m *Map[T, P]) KeysSeq() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		it := m.tree.Iterator()
+		for it.Next() {
+			if !yield(it.Key()) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns a lazy iterator over all values in-order based on the key, e.g. for value := range m.ValuesSeq() {...}.
+// Unlike Values(), no slice of values is allocated.
+func (m *Map[T, P]) ValuesSeq() iter.Seq[P] {
+	return func(yield func(P) bool) {
+		it := m.tree.Iterator()
+		for it.Next() {
+			if !yield(it.Value()) {
+				return
+			}
+		}
+	}
+}
+
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
 // If Next() returns true, then next element's key and value can be retrieved by Key() and Value().
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
@@ -50,12 +78,31 @@ func (iterator *Iterator[T, P]) Key() T {
 	return iterator.iterator.Key()
 }
 
+// KeyValue returns the current element's key and value as an entry.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[T, P]) KeyValue() containers.Entry[T, P] {
+	return containers.Entry[T, P]{Key: iterator.Key(), Value: iterator.Value()}
+}
+
 // Begin resets the iterator to its initial state (one-before-first)
 // Call Next() to fetch the first element if any.
 func (iterator *Iterator[T, P]) Begin() {
 	iterator.iterator.Begin()
 }
 
+// Reset resets the iterator to its initial state (one-before-first), same as Begin().
+// Call Next() to fetch the first element if any.
+func (iterator *Iterator[T, P]) Reset() {
+	iterator.Begin()
+}
+
+// Done returns true if the iterator is past the last element (one-past-the-end),
+// e.g. after Next() returned false or End() was called.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[T, P]) Done() bool {
+	return iterator.iterator.Done()
+}
+
 // End moves the iterator past the last element (one-past-the-end).
 // Call Prev() to fetch the last element if any.
 func (iterator *Iterator[T, P]) End() {
@@ -0,0 +1,60 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package treemap
+
+import (
+	"github.com/lemonyxk/gods/trees/binaryheap"
+	"github.com/lemonyxk/gods/utils"
+)
+
+// mergeSource is the current position of a k-way merge within one of the input maps.
+type mergeSource[T comparable, P any] struct {
+	iterator Iterator[T, P]
+	index    int
+}
+
+// MergeSorted merges the given maps into a new map ordered by the comparator.
+// On key collisions, the value of the map passed last wins.
+//
+// The maps are merged with a k-way merge of their iterators over a binary heap, i.e. in O(N log k)
+// comparisons for N elements in k maps. The maps must be ordered by the same comparator.
+func MergeSorted[T comparable, P any](comparator utils.Comparator, maps ...*Map[T, P]) *Map[T, P] {
+	return MergeSortedWith[T, P](comparator, nil, maps...)
+}
+
+// MergeSortedWith merges the given maps into a new map like MergeSorted, but on key collisions
+// the value is decided by calling resolve with the value merged so far and the value of the map passed later.
+// A nil resolve lets the value of the map passed last win.
+func MergeSortedWith[T comparable, P any](comparator utils.Comparator, resolve func(key T, existing, incoming P) P, maps ...*Map[T, P]) *Map[T, P] {
+	heap := binaryheap.NewWith[*mergeSource[T, P]](func(a, b interface{}) int {
+		sourceA, sourceB := a.(*mergeSource[T, P]), b.(*mergeSource[T, P])
+		if compare := comparator(sourceA.iterator.Key(), sourceB.iterator.Key()); compare != 0 {
+			return compare
+		}
+		return sourceA.index - sourceB.index
+	})
+	for index, m := range maps {
+		source := &mergeSource[T, P]{iterator: m.Iterator(), index: index}
+		if source.iterator.Next() {
+			heap.Push(source)
+		}
+	}
+
+	merged := NewWith[T, P](comparator)
+	var lastKey T
+	var lastValue P
+	for source, ok := heap.Pop(); ok; source, ok = heap.Pop() {
+		key, value := source.iterator.Key(), source.iterator.Value()
+		if !merged.Empty() && comparator(lastKey, key) == 0 && resolve != nil {
+			value = resolve(key, lastValue, value)
+		}
+		merged.Put(key, value)
+		lastKey, lastValue = key, value
+		if source.iterator.Next() {
+			heap.Push(source)
+		}
+	}
+	return merged
+}
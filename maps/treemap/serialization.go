@@ -4,7 +4,12 @@
 
 package treemap
 
-import "github.com/lemonyxk/gods/containers"
+import (
+	"encoding/json"
+
+	"github.com/lemonyxk/gods/containers"
+	"github.com/lemonyxk/gods/maps"
+)
 
 func assertSerializationImplementation[T comparable, P any]() {
 	var _ containers.JSONSerializer = (*Map[T, P])(nil)
@@ -16,7 +21,79 @@ func (m *Map[T, P]) ToJSON() ([]byte, error) {
 	return m.tree.ToJSON()
 }
 
+// ToJSONWithKeyFunc outputs the JSON representation of the map like ToJSON, but keys are formatted with the given function,
+// e.g. to zero-pad integer keys. The function should map distinct keys to distinct strings.
+func (m *Map[T, P]) ToJSONWithKeyFunc(keyFn func(key T) string) ([]byte, error) {
+	elements := make(map[string]interface{}, m.Size())
+	it := m.Iterator()
+	for it.Next() {
+		elements[keyFn(it.Key())] = it.Value()
+	}
+	return json.Marshal(&elements)
+}
+
 // FromJSON populates the map from the input JSON representation.
+// The map is left untouched if the input can not be decoded.
+// Elements beyond the capacity of the map, if set, are evicted.
 func (m *Map[T, P]) FromJSON(data []byte) error {
-	return m.tree.FromJSON(data)
+	m.checkFrozen()
+	err := m.tree.FromJSON(data)
+	m.evict()
+	return err
+}
+
+// ToJSONTyped outputs the JSON representation of the map as an array of [key,value] pairs in-order.
+// Unlike ToJSON, keys are not coerced into strings, so both keys and values keep their full type fidelity.
+func (m *Map[T, P]) ToJSONTyped() ([]byte, error) {
+	elements := make([][2]interface{}, 0, m.Size())
+	it := m.Iterator()
+	for it.Next() {
+		elements = append(elements, [2]interface{}{it.Key(), it.Value()})
+	}
+	return json.Marshal(&elements)
+}
+
+// FromJSONTyped populates the map from the input JSON representation produced by ToJSONTyped.
+// The map is left untouched if the input can not be decoded.
+func (m *Map[T, P]) FromJSONTyped(data []byte) error {
+	m.checkFrozen()
+	var elements [][2]json.RawMessage
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	keys := make([]T, len(elements))
+	values := make([]P, len(elements))
+	for i, element := range elements {
+		if err := json.Unmarshal(element[0], &keys[i]); err != nil {
+			return err
+		}
+		if err := json.Unmarshal(element[1], &values[i]); err != nil {
+			return err
+		}
+	}
+	m.Clear()
+	for i := range keys {
+		m.Put(keys[i], values[i])
+	}
+	return nil
+}
+
+// FromJSONStrict populates the map from the input JSON representation like FromJSON,
+// but returns an error naming the duplicate key if the input contains the same key more than once.
+// The map is left untouched if the input is rejected.
+func (m *Map[T, P]) FromJSONStrict(data []byte) error {
+	if err := maps.CheckDuplicateJSONKeys(data); err != nil {
+		return err
+	}
+	return m.FromJSON(data)
+}
+
+// FromJSONLimited populates the map from the input JSON representation like FromJSON,
+// but returns an error if arrays and objects in the input are nested deeper than maxDepth,
+// e.g. to guard against adversarial input. The map is left untouched if the input is rejected.
+func (m *Map[T, P]) FromJSONLimited(data []byte, maxDepth int) error {
+	if err := maps.CheckJSONDepth(data, maxDepth); err != nil {
+		return err
+	}
+	return m.FromJSON(data)
 }
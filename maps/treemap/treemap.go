@@ -15,6 +15,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/lemonyxk/gods/containers"
 	"github.com/lemonyxk/gods/maps"
 	rbt "github.com/lemonyxk/gods/trees/redblacktree"
 	"github.com/lemonyxk/gods/utils"
@@ -26,10 +27,14 @@ func assertMapImplementation[T comparable, P any]() {
 
 // Map holds the elements in a red-black tree
 type Map[T comparable, P any] struct {
-	tree *rbt.Tree[T, P]
+	tree         *rbt.Tree[T, P]
+	capacity     int
+	evictLargest bool
+	frozen       bool
 }
 
 // NewWith instantiates a tree map with the custom comparator.
+// Panics if comparator is nil.
 func NewWith[T comparable, P any](comparator utils.Comparator) *Map[T, P] {
 	return &Map[T, P]{tree: rbt.NewWith[T, P](comparator)}
 }
@@ -44,10 +49,38 @@ func NewWithStringComparator[T comparable, P any]() *Map[T, P] {
 	return &Map[T, P]{tree: rbt.NewWithStringComparator[T, P]()}
 }
 
+// NewFromSorted instantiates a tree map with the custom comparator holding the given keys and values,
+// where the i-th key is mapped to the i-th value. The keys must be sorted in strictly ascending order
+// with respect to the comparator, which lets the balanced tree be built in O(n) instead of inserting keys one by one.
+// Panics if comparator is nil, if the number of keys and values differ or if the keys are not strictly ascending.
+func NewFromSorted[T comparable, P any](comparator utils.Comparator, keys []T, values []P) *Map[T, P] {
+	return &Map[T, P]{tree: rbt.NewFromSorted[T, P](comparator, keys, values)}
+}
+
 // Put inserts key-value pair into the map.
 // Key should adhere to the comparator's type assertion, otherwise method panics.
+// If the map has a capacity set and grows beyond it, the smallest (or largest) key is evicted.
 func (m *Map[T, P]) Put(key T, value P) {
+	m.checkFrozen()
 	m.tree.Put(key, value)
+	m.evict()
+}
+
+// PutIfAbsent inserts key-value pair into the map only if the key is not in the map yet, in a single tree descent.
+// Returns the existing value and true if the key was found, otherwise the inserted value and false.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+// If the map has a capacity set and grows beyond it, the smallest (or largest) key is evicted.
+func (m *Map[T, P]) PutIfAbsent(key T, value P) (actual P, loaded bool) {
+	if m.frozen {
+		if value, found := m.tree.Get(key); found {
+			return value, true
+		}
+	}
+	m.checkFrozen()
+	if actual, loaded = m.tree.PutIfAbsent(key, value); !loaded {
+		m.evict()
+	}
+	return actual, loaded
 }
 
 // Get searches the element in the map by key and returns its value or nil if key is not found in tree.
@@ -57,12 +90,165 @@ func (m *Map[T, P]) Get(key T) (value P, found bool) {
 	return m.tree.Get(key)
 }
 
+// GetOrDefault returns the value of the key if it is in the map, otherwise def.
+// Does not modify the map.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[T, P]) GetOrDefault(key T, def P) P {
+	if value, found := m.tree.Get(key); found {
+		return value
+	}
+	return def
+}
+
+// GetOrComputeErr returns the value of the key if it is in the map, otherwise it calls f with the key
+// and stores and returns the computed value, e.g. to load a cache entry from a database.
+// If f returns an error nothing is stored, so that a failed load does not leave a zero value behind, and the error is returned.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[T, P]) GetOrComputeErr(key T, f func(key T) (P, error)) (P, error) {
+	if value, found := m.tree.Get(key); found {
+		return value, nil
+	}
+	m.checkFrozen()
+	value, err := f(key)
+	if err != nil {
+		return value, err
+	}
+	m.Put(key, value)
+	return value, nil
+}
+
+// ContainsSorted reports for each of the given keys whether it is in the map, aligned to the input.
+// The keys must be sorted in ascending order with respect to the map's comparator (duplicates are allowed),
+// so that they can be merged against the in-order walk of the map in O(n + q) instead of q independent lookups.
+// Panics if the keys are not sorted.
+func (m *Map[T, P]) ContainsSorted(keys []T) []bool {
+	found := make([]bool, len(keys))
+	it := m.tree.Iterator()
+	ok := it.Next()
+	for i, key := range keys {
+		if i > 0 && m.tree.Comparator(keys[i-1], key) > 0 {
+			panic("keys must be sorted in ascending order")
+		}
+		for ok && m.tree.Comparator(it.Key(), key) < 0 {
+			ok = it.Next()
+		}
+		found[i] = ok && m.tree.Comparator(it.Key(), key) == 0
+	}
+	return found
+}
+
+// UpdateValue replaces the value of the key in place with the result of f applied to the old value,
+// descending the tree only once, e.g. for counters and accumulators.
+// Returns false if key is not found in map, in which case f is not called.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[T, P]) UpdateValue(key T, f func(old P) P) bool {
+	m.checkFrozen()
+	return m.tree.UpdateValue(key, f)
+}
+
+// Merge inserts the key-value pair if the key is not in the map, otherwise it replaces the value of the key
+// with the result of remap applied to the old and the given value, e.g. to sum up counts.
+// The lookup and the insertion or replacement share a single tree descent.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+// If the map has a capacity set and grows beyond it, the smallest (or largest) key is evicted.
+func (m *Map[T, P]) Merge(key T, value P, remap func(old, new P) P) {
+	m.checkFrozen()
+	m.tree.Compute(key, func(old P, found bool) (P, bool) {
+		if found {
+			return remap(old, value), true
+		}
+		return value, true
+	})
+	m.evict()
+}
+
+// Compute calls f with the current value of the key and whether the key was found in the map,
+// then stores the value returned by f if its second return value is true, or removes the key otherwise.
+// Removing a key that is not in the map does nothing. The lookup and the insertion, replacement or removal
+// share a single tree descent. f must not modify the map.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+// If the map has a capacity set and grows beyond it, the smallest (or largest) key is evicted.
+func (m *Map[T, P]) Compute(key T, f func(old P, found bool) (P, bool)) {
+	m.checkFrozen()
+	m.tree.Compute(key, f)
+	m.evict()
+}
+
+// CompareAndSwap replaces the value of the key with newValue only if its current value equals oldValue,
+// as decided by the equal function or reflect.DeepEqual if equal is nil.
+// Returns true if the value was swapped, false if the values differ or the key is not found in the map.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[T, P]) CompareAndSwap(key T, oldValue, newValue P, equal func(a, b P) bool) bool {
+	m.checkFrozen()
+	node := m.tree.GetNode(key)
+	if node == nil || !maps.ValuesEqual(node.Value, oldValue, equal) {
+		return false
+	}
+	node.Value = newValue
+	return true
+}
+
 // Remove removes the element from the map by key.
 // Key should adhere to the comparator's type assertion, otherwise method panics.
 func (m *Map[T, P]) Remove(key T) {
+	m.checkFrozen()
 	m.tree.Remove(key)
 }
 
+// Swap exchanges the values of the two given keys.
+// Returns false (and leaves the map untouched) if either key is not found in the map.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[T, P]) Swap(key1, key2 T) bool {
+	m.checkFrozen()
+	node1 := m.tree.GetNode(key1)
+	if node1 == nil {
+		return false
+	}
+	node2 := m.tree.GetNode(key2)
+	if node2 == nil {
+		return false
+	}
+	node1.Value, node2.Value = node2.Value, node1.Value
+	return true
+}
+
+// RemoveIf removes all elements for which the given function returns true and returns the number of removed elements.
+// Removing a large fraction of the map rebuilds the underlying tree in O(n) instead of removing elements one by one.
+func (m *Map[T, P]) RemoveIf(f func(key T, value P) bool) int {
+	m.checkFrozen()
+	return m.tree.RemoveIf(f)
+}
+
+// RemoveRangeIf removes all elements with keys within [lo, hi] for which the given function returns true
+// and returns the number of removed elements. Elements outside of the range are not visited.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[T, P]) RemoveRangeIf(lo, hi T, f func(key T, value P) bool) int {
+	m.checkFrozen()
+	node, found := m.tree.Ceiling(lo)
+	if !found {
+		return 0
+	}
+	var keys []T
+	it := m.tree.IteratorAt(node)
+	for ok := true; ok && m.tree.Comparator(it.Key(), hi) <= 0; ok = it.Next() {
+		if f(it.Key(), it.Value()) {
+			keys = append(keys, it.Key())
+		}
+	}
+	for _, key := range keys {
+		m.tree.Remove(key)
+	}
+	return len(keys)
+}
+
+// Compact rebuilds the underlying tree into a perfectly balanced shape in O(n), e.g. during idle periods after bulk removals.
+// The contents and order of the map are unchanged, only the internal shape of the tree.
+// Cursors and iterators obtained before compacting must not be used afterwards.
+func (m *Map[T, P]) Compact() {
+	m.checkFrozen()
+	m.tree.Rebuild()
+}
+
 // Empty returns true if map does not contain any elements
 func (m *Map[T, P]) Empty() bool {
 	return m.tree.Empty()
@@ -83,8 +269,49 @@ func (m *Map[T, P]) Values() []P {
 	return m.tree.Values()
 }
 
+// Snapshot returns all key-value pairs in-order based on the key.
+// The returned slice is a copy taken at call time and is not affected by later modifications of the map.
+func (m *Map[T, P]) Snapshot() []containers.Entry[T, P] {
+	entries := make([]containers.Entry[T, P], m.Size())
+	it := m.Iterator()
+	for i := 0; it.Next(); i++ {
+		entries[i] = it.KeyValue()
+	}
+	return entries
+}
+
+// EntriesByValue returns all key-value pairs sorted by value with respect to the given comparator,
+// e.g. for a "top by value" report. The order of pairs with equal values is not specified.
+// The map itself stays ordered by key.
+func (m *Map[T, P]) EntriesByValue(valueComparator utils.Comparator) []containers.Entry[T, P] {
+	entries := m.Snapshot()
+	utils.Sort(entries, func(a, b interface{}) int {
+		return valueComparator(a.(containers.Entry[T, P]).Value, b.(containers.Entry[T, P]).Value)
+	})
+	return entries
+}
+
+// Clone returns an independent copy of the map with the same comparator and capacity, with values copied by assignment.
+// The underlying tree is built balanced in O(n) from the in-order elements. Modifying either map does not affect the other.
+// The copy is never frozen.
+func (m *Map[T, P]) Clone() *Map[T, P] {
+	keys := make([]T, 0, m.Size())
+	values := make([]P, 0, m.Size())
+	it := m.tree.Iterator()
+	for it.Next() {
+		keys = append(keys, it.Key())
+		values = append(values, it.Value())
+	}
+	return &Map[T, P]{
+		tree:         rbt.NewFromSorted[T, P](m.tree.Comparator, keys, values),
+		capacity:     m.capacity,
+		evictLargest: m.evictLargest,
+	}
+}
+
 // Clear removes all elements from the map.
 func (m *Map[T, P]) Clear() {
+	m.checkFrozen()
 	m.tree.Clear()
 }
 
@@ -106,6 +333,94 @@ func (m *Map[T, P]) Max() (key interface{}, value interface{}) {
 	return nil, nil
 }
 
+// SmallestKeys returns up to k smallest keys in ascending order.
+// Only the first k nodes are visited, i.e. it takes O(k + log n) instead of allocating all keys.
+func (m *Map[T, P]) SmallestKeys(k int) []T {
+	keys := make([]T, 0, min(max(k, 0), m.Size()))
+	it := m.tree.Iterator()
+	for len(keys) < k && it.Next() {
+		keys = append(keys, it.Key())
+	}
+	return keys
+}
+
+// LargestKeys returns up to k largest keys in descending order.
+// Only the last k nodes are visited, i.e. it takes O(k + log n) instead of allocating all keys.
+func (m *Map[T, P]) LargestKeys(k int) []T {
+	keys := make([]T, 0, min(max(k, 0), m.Size()))
+	it := m.tree.Iterator()
+	it.End()
+	for len(keys) < k && it.Prev() {
+		keys = append(keys, it.Key())
+	}
+	return keys
+}
+
+// PollFirst removes the minimum key and its value from the map and returns them.
+// Third return parameter is true, unless the map was empty and there was nothing to remove.
+func (m *Map[T, P]) PollFirst() (key T, value P, ok bool) {
+	m.checkFrozen()
+	node := m.tree.Left()
+	if node == nil {
+		return utils.AnyEmpty[T](), utils.AnyEmpty[P](), false
+	}
+	key, value = node.Key, node.Value
+	m.tree.Remove(key)
+	return key, value, true
+}
+
+// PollLast removes the maximum key and its value from the map and returns them.
+// Third return parameter is true, unless the map was empty and there was nothing to remove.
+func (m *Map[T, P]) PollLast() (key T, value P, ok bool) {
+	m.checkFrozen()
+	node := m.tree.Right()
+	if node == nil {
+		return utils.AnyEmpty[T](), utils.AnyEmpty[P](), false
+	}
+	key, value = node.Key, node.Value
+	m.tree.Remove(key)
+	return key, value, true
+}
+
+// SetCapacity bounds the map to at most n elements, turning it into an ordered window.
+// Whenever a Put grows the map beyond n elements, the smallest key is evicted,
+// or the largest key if evictLargest is true. Each eviction takes O(log n).
+// Elements beyond the capacity are evicted right away. A capacity of 0 disables eviction.
+func (m *Map[T, P]) SetCapacity(n int, evictLargest bool) {
+	m.checkFrozen()
+	m.capacity = n
+	m.evictLargest = evictLargest
+	m.evict()
+}
+
+// Reorder rebuilds the map under the new comparator, keeping all key-value pairs,
+// e.g. when the ordering rule depends on runtime configuration.
+// The entries are sorted once under the new comparator and the balanced tree is built from them,
+// i.e. O(n log n), or O(n) if the entries happen to be sorted under the new comparator already.
+// Cursors and iterators obtained before reordering must not be used afterwards.
+// Panics if comparator is nil or reports two distinct keys of the map as equal.
+func (m *Map[T, P]) Reorder(comparator utils.Comparator) {
+	m.checkFrozen()
+	if comparator == nil {
+		panic("comparator must not be nil")
+	}
+	entries := m.Snapshot()
+	for i := 1; i < len(entries); i++ {
+		if comparator(entries[i-1].Key, entries[i].Key) >= 0 {
+			utils.Sort(entries, func(a, b interface{}) int {
+				return comparator(a.(containers.Entry[T, P]).Key, b.(containers.Entry[T, P]).Key)
+			})
+			break
+		}
+	}
+	keys := make([]T, len(entries))
+	values := make([]P, len(entries))
+	for i, entry := range entries {
+		keys[i], values[i] = entry.Key, entry.Value
+	}
+	m.tree = rbt.NewFromSorted[T, P](comparator, keys, values)
+}
+
 // Floor finds the floor key-value pair for the input key.
 // In case that no floor is found, then both returned values will be nil.
 // It's generally enough to check the first value (key) for nil, which determines if floor was found.
@@ -140,6 +455,43 @@ func (m *Map[T, P]) Ceiling(key T) (foundKey T, foundValue P) {
 	return utils.AnyEmpty[T](), utils.AnyEmpty[P]()
 }
 
+// FloorExact finds the floor key-value pair for the input key like Floor, descending the tree only once.
+// Third return parameter is true if the floor key equals the input key, i.e. it is an exact match.
+// Fourth return parameter is true if floor was found, otherwise false.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[T, P]) FloorExact(key T) (foundKey T, value P, exact bool, found bool) {
+	node, found := m.tree.Floor(key)
+	if !found {
+		return utils.AnyEmpty[T](), utils.AnyEmpty[P](), false, false
+	}
+	return node.Key, node.Value, m.tree.Comparator(key, node.Key) == 0, true
+}
+
+// CeilingExact finds the ceiling key-value pair for the input key like Ceiling, descending the tree only once.
+// Third return parameter is true if the ceiling key equals the input key, i.e. it is an exact match.
+// Fourth return parameter is true if ceiling was found, otherwise false.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[T, P]) CeilingExact(key T) (foundKey T, value P, exact bool, found bool) {
+	node, found := m.tree.Ceiling(key)
+	if !found {
+		return utils.AnyEmpty[T](), utils.AnyEmpty[P](), false, false
+	}
+	return node.Key, node.Value, m.tree.Comparator(key, node.Key) == 0, true
+}
+
+// Freeze makes the map immutable; later modifying calls panic with "container is frozen".
+// Reads remain allowed. Freezing is one-way.
+func (m *Map[T, P]) Freeze() {
+	m.frozen = true
+}
+
+// Frozen returns true if the map has been frozen.
+func (m *Map[T, P]) Frozen() bool {
+	return m.frozen
+}
+
 // String returns a string representation of container
 func (m *Map[T, P]) String() string {
 	str := "TreeMap\nmap["
@@ -150,3 +502,21 @@ func (m *Map[T, P]) String() string {
 	return strings.TrimRight(str, " ") + "]"
 
 }
+
+// evict removes extreme keys while the map holds more elements than its capacity.
+func (m *Map[T, P]) evict() {
+	for m.capacity > 0 && m.tree.Size() > m.capacity {
+		if m.evictLargest {
+			m.PollLast()
+		} else {
+			m.PollFirst()
+		}
+	}
+}
+
+// checkFrozen panics if the map has been frozen.
+func (m *Map[T, P]) checkFrozen() {
+	if m.frozen {
+		panic("container is frozen")
+	}
+}
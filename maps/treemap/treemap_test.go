@@ -5,9 +5,15 @@
 package treemap
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"testing"
 
+	"github.com/lemonyxk/gods/containers"
+	"github.com/lemonyxk/gods/maps"
+	"github.com/lemonyxk/gods/maps/hashmap"
 	"github.com/lemonyxk/gods/utils"
 )
 
@@ -53,6 +59,63 @@ func TestMapPut(t *testing.T) {
 	}
 }
 
+func TestMapNewFromSorted(t *testing.T) {
+	m := NewFromSorted[int, string](utils.IntComparator, []int{1, 2, 3}, []string{"a", "b", "c"})
+	if actualValue, expectedValue := fmt.Sprint(m.Keys(), m.Values()), "[1 2 3] [a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.Put(0, "z")
+	if actualValue, found := m.Get(0); actualValue != "z" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "z")
+	}
+}
+
+func TestMapUpdateValue(t *testing.T) {
+	m := NewWithIntComparator[int, int]()
+	for i := 1; i <= 10; i++ {
+		m.Put(i, 0)
+	}
+	for _, key := range []int{3, 7, 3} {
+		if actualValue := m.UpdateValue(key, func(old int) int { return old + 1 }); actualValue != true {
+			t.Errorf("Got %v expected %v", actualValue, true)
+		}
+	}
+	if actualValue := m.UpdateValue(11, func(old int) int { return old + 1 }); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Values()), "[0 0 2 0 0 0 1 0 0 0]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapCompareAndSwap(t *testing.T) {
+	m := NewWithStringComparator[string, []int]()
+	m.Put("a", []int{1})
+	m.Put("b", []int{2})
+
+	if actualValue := m.CompareAndSwap("a", []int{1}, []int{3}, nil); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := m.CompareAndSwap("a", []int{1}, []int{4}, nil); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := m.CompareAndSwap("x", nil, []int{4}, nil); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	sameLength := func(a, b []int) bool {
+		return len(a) == len(b)
+	}
+	if actualValue := m.CompareAndSwap("b", []int{9}, []int{5}, sameLength); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Values()), "[[3] [5]]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if _, found := m.Get("x"); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+}
+
 func TestMapRemove(t *testing.T) {
 	m := NewWithIntComparator[int, string]()
 	m.Put(5, "e")
@@ -120,6 +183,164 @@ func TestMapRemove(t *testing.T) {
 	}
 }
 
+func TestMapSwap(t *testing.T) {
+	m := NewWithIntComparator[int, string]()
+	m.Put(1, "a")
+	m.Put(2, "b")
+	m.Put(3, "c")
+
+	if actualValue := m.Swap(1, 3); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, _ := m.Get(1); actualValue != "c" {
+		t.Errorf("Got %v expected %v", actualValue, "c")
+	}
+	if actualValue, _ := m.Get(3); actualValue != "a" {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+	if actualValue := m.Swap(2, 4); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue, _ := m.Get(2); actualValue != "b" {
+		t.Errorf("Got %v expected %v", actualValue, "b")
+	}
+	if actualValue := m.Swap(2, 2); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := m.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+}
+
+func TestMapSnapshot(t *testing.T) {
+	m := NewWithIntComparator[int, string]()
+	if actualValue := m.Snapshot(); len(actualValue) != 0 {
+		t.Errorf("Got %v expected %v", actualValue, "[]")
+	}
+	m.Put(3, "c")
+	m.Put(1, "a")
+	m.Put(2, "b")
+
+	snapshot := m.Snapshot()
+	m.Remove(2)
+	m.Put(4, "d")
+	m.Put(1, "x")
+
+	expected := []containers.Entry[int, string]{{Key: 1, Value: "a"}, {Key: 2, Value: "b"}, {Key: 3, Value: "c"}}
+	if actualValue, expectedValue := snapshot, expected; !sameElements(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	for i, entry := range snapshot {
+		if entry != expected[i] {
+			t.Errorf("Got %v expected %v", entry, expected[i])
+		}
+	}
+}
+
+func TestMapEntriesByValue(t *testing.T) {
+	m := NewWithIntComparator[int, string]()
+	if actualValue := m.EntriesByValue(utils.StringComparator); len(actualValue) != 0 {
+		t.Errorf("Got %v expected %v", actualValue, "[]")
+	}
+	m.Put(1, "c")
+	m.Put(2, "a")
+	m.Put(3, "b")
+
+	expected := []containers.Entry[int, string]{{Key: 2, Value: "a"}, {Key: 3, Value: "b"}, {Key: 1, Value: "c"}}
+	if actualValue, expectedValue := m.EntriesByValue(utils.StringComparator), expected; fmt.Sprint(actualValue) != fmt.Sprint(expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.Keys(), []int{1, 2, 3}; fmt.Sprint(actualValue) != fmt.Sprint(expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapRemoveIf(t *testing.T) {
+	m := NewWithIntComparator[int, string]()
+	m.Put(1, "a")
+	m.Put(2, "b")
+	m.Put(3, "c")
+	m.Put(4, "d")
+
+	removed := m.RemoveIf(func(key int, value string) bool {
+		return key%2 == 0
+	})
+	if actualValue := removed; actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, expectedValue := m.Keys(), []int{1, 3}; !sameElements(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapRemoveRangeIf(t *testing.T) {
+	m := NewWithIntComparator[int, int]()
+	for i := 1; i <= 10; i++ {
+		m.Put(i, i*10)
+	}
+	visited := 0
+	removed := m.RemoveRangeIf(3, 8, func(key int, value int) bool {
+		visited++
+		return value%20 == 0
+	})
+	if actualValue := removed; actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue := visited; actualValue != 6 {
+		t.Errorf("Got %v expected %v", actualValue, 6)
+	}
+	if actualValue, expectedValue := fmt.Sprint(m.Keys()), "[1 2 3 5 7 9 10]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := m.RemoveRangeIf(11, 20, func(key int, value int) bool { return true }); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue := m.RemoveRangeIf(8, 4, func(key int, value int) bool { return true }); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue := m.RemoveRangeIf(0, 100, func(key int, value int) bool { return true }); actualValue != 7 {
+		t.Errorf("Got %v expected %v", actualValue, 7)
+	}
+	if actualValue := m.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestMapFreeze(t *testing.T) {
+	m := NewWithIntComparator[int, string]()
+	m.Put(1, "a")
+	m.Put(2, "b")
+	if actualValue := m.Frozen(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	m.Freeze()
+	assertFrozenPanic(t, func() { m.Put(3, "c") })
+	assertFrozenPanic(t, func() { m.Remove(1) })
+	assertFrozenPanic(t, func() { m.PollFirst() })
+	assertFrozenPanic(t, func() { m.UpdateValue(1, func(old string) string { return old + old }) })
+	assertFrozenPanic(t, func() { m.Clear() })
+	assertFrozenPanic(t, func() { m.PutIfAbsent(3, "c") })
+	if actualValue, loaded := m.PutIfAbsent(1, "c"); actualValue != "a" || !loaded {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+	if actualValue, expectedValue := fmt.Sprint(m.Keys()), "[1 2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, found := m.Get(1); actualValue != "a" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+}
+
+// assertFrozenPanic asserts that f panics because the container is frozen.
+func assertFrozenPanic(t *testing.T, f func()) {
+	defer func() {
+		if r := recover(); r != "container is frozen" {
+			t.Errorf("Got %v expected %v", r, "container is frozen")
+		}
+	}()
+	f()
+}
+
 func TestMapFloor(t *testing.T) {
 	m := NewWithIntComparator[int, string]()
 	m.Put(7, "g")
@@ -176,6 +397,68 @@ func TestMapCeiling(t *testing.T) {
 	}
 }
 
+func TestMapFloorCeilingExact(t *testing.T) {
+	m := NewWithIntComparator[int, string]()
+	m.Put(7, "g")
+	m.Put(3, "c")
+	m.Put(1, "a")
+
+	// key,expectedKey,expectedValue,expectedExact,expectedFound
+	tests1 := [][]interface{}{
+		{0, 0, "", false, false},
+		{1, 1, "a", true, true},
+		{2, 1, "a", false, true},
+		{3, 3, "c", true, true},
+		{8, 7, "g", false, true},
+	}
+	for _, test := range tests1 {
+		actualKey, actualValue, actualExact, actualFound := m.FloorExact(test[0].(int))
+		if actualKey != test[1] || actualValue != test[2] || actualExact != test[3] || actualFound != test[4] {
+			t.Errorf("Got %v, %v, %v, %v, expected %v, %v, %v, %v", actualKey, actualValue, actualExact, actualFound, test[1], test[2], test[3], test[4])
+		}
+	}
+
+	// key,expectedKey,expectedValue,expectedExact,expectedFound
+	tests2 := [][]interface{}{
+		{0, 1, "a", false, true},
+		{1, 1, "a", true, true},
+		{4, 7, "g", false, true},
+		{7, 7, "g", true, true},
+		{8, 0, "", false, false},
+	}
+	for _, test := range tests2 {
+		actualKey, actualValue, actualExact, actualFound := m.CeilingExact(test[0].(int))
+		if actualKey != test[1] || actualValue != test[2] || actualExact != test[3] || actualFound != test[4] {
+			t.Errorf("Got %v, %v, %v, %v, expected %v, %v, %v, %v", actualKey, actualValue, actualExact, actualFound, test[1], test[2], test[3], test[4])
+		}
+	}
+}
+
+func TestMapFromJSONLimited(t *testing.T) {
+	m := NewWithStringComparator[string, []interface{}]()
+	m.Put("z", nil)
+
+	deep := `{"a":` + strings.Repeat("[", 100) + strings.Repeat("]", 100) + `}`
+	if err := m.FromJSONLimited([]byte(deep), 10); err == nil || err.Error() != "nesting exceeds maximum depth 10" {
+		t.Errorf("Got %v expected %v", err, "nesting exceeds maximum depth 10")
+	}
+	if actualValue, expectedValue := m.Keys(), []string{"z"}; !sameElements(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := m.FromJSONLimited([]byte(`{"a":[[1]],"b":[]}`), 3); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := m.Keys(), []string{"a", "b"}; !sameElements(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := m.FromJSONLimited([]byte(`{"a":[[1]]}`), 2); err == nil {
+		t.Errorf("Expected error")
+	}
+	if err := m.FromJSONLimited([]byte(`{"a":[`), 10); err == nil {
+		t.Errorf("Expected error")
+	}
+}
+
 func sameElements[T comparable](a []T, b []T) bool {
 	if len(a) != len(b) {
 		return false
@@ -304,116 +587,524 @@ func TestMapAll(t *testing.T) {
 	}
 }
 
-func TestMapFind(t *testing.T) {
+func TestMapMinMaxBy(t *testing.T) {
 	m := NewWithStringComparator[string, int]()
-	m.Put("c", 3)
-	m.Put("a", 1)
-	m.Put("b", 2)
-	foundKey, foundValue := m.Find(func(key string, value int) bool {
-		return key == "c"
-	})
-	if foundKey != "c" || foundValue != 3 {
-		t.Errorf("Got %v -> %v expected %v -> %v", foundKey, foundValue, "c", 3)
+	score := func(key string, value int) float64 {
+		return float64(value)
 	}
-	foundKey, foundValue = m.Find(func(key string, value int) bool {
-		return key == "x"
-	})
-	if foundKey != "" || foundValue != 0 {
-		t.Errorf("Got %v at %v expected %v at %v", foundValue, foundKey, nil, nil)
+	if _, _, ok := m.MinBy(score); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	m.Put("a", 5)
+	m.Put("b", -2)
+	m.Put("c", 9)
+	m.Put("d", -2)
+	if key, value, ok := m.MinBy(score); key != "b" || value != -2 || !ok {
+		t.Errorf("Got %v -> %v expected %v -> %v", key, value, "b", -2)
+	}
+	if key, value, ok := m.MaxBy(score); key != "c" || value != 9 || !ok {
+		t.Errorf("Got %v -> %v expected %v -> %v", key, value, "c", 9)
 	}
 }
 
-func TestMapChaining(t *testing.T) {
-	m := NewWithStringComparator[string, int]()
-	m.Put("c", 3)
-	m.Put("a", 1)
-	m.Put("b", 2)
-	chainedMap := m.Select(func(key string, value int) bool {
-		return value > 1
-	}).Map(func(key string, value int) (string, int) {
-		return key + key, value * value
-	})
-	if actualValue := chainedMap.Size(); actualValue != 2 {
-		t.Errorf("Got %v expected %v", actualValue, 2)
+func TestMapCopy(t *testing.T) {
+	src := hashmap.New[int, string]()
+	src.Put(3, "c")
+	src.Put(1, "a")
+	src.Put(2, "b")
+
+	m := NewWithIntComparator[int, string]()
+	m.Put(1, "x")
+	m.Put(4, "d")
+	maps.Copy[int, string](m, src)
+	if actualValue, expectedValue := fmt.Sprintf("%v", m), "TreeMap\nmap[1:a 2:b 3:c 4:d]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
 	}
-	if actualValue, found := chainedMap.Get("aa"); actualValue != 0 || found {
-		t.Errorf("Got %v expected %v", actualValue, nil)
+
+	maps.Copy[int, string](m, m)
+	if actualValue, expectedValue := fmt.Sprintf("%v", m), "TreeMap\nmap[1:a 2:b 3:c 4:d]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
 	}
-	if actualValue, found := chainedMap.Get("bb"); actualValue != 4 || !found {
-		t.Errorf("Got %v expected %v", actualValue, 4)
+}
+
+func TestMapCompact(t *testing.T) {
+	m := NewWithIntComparator[int, int]()
+	for i := 0; i < 100; i++ {
+		m.Put(i, i*10)
 	}
-	if actualValue, found := chainedMap.Get("cc"); actualValue != 9 || !found {
-		t.Errorf("Got %v expected %v", actualValue, 9)
+	m.RemoveRangeIf(0, 89, func(key int, value int) bool { return key%10 != 0 })
+	expectedKeys := fmt.Sprint(m.Keys())
+	m.Compact()
+	if actualValue := fmt.Sprint(m.Keys()); actualValue != expectedKeys {
+		t.Errorf("Got %v expected %v", actualValue, expectedKeys)
+	}
+	if actualValue, found := m.Get(95); actualValue != 950 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 950)
+	}
+	m.Put(-1, -10)
+	if actualValue := m.Size(); actualValue != 20 {
+		t.Errorf("Got %v expected %v", actualValue, 20)
 	}
+	NewWithIntComparator[int, int]().Compact()
 }
 
-func TestMapIteratorNextOnEmpty(t *testing.T) {
-	m := NewWithStringComparator[int, int]()
-	it := m.Iterator()
-	it = m.Iterator()
-	for it.Next() {
-		t.Errorf("Shouldn't iterate on empty map")
+func TestMapGetOrComputeErr(t *testing.T) {
+	m := NewWithIntComparator[int, string]()
+	m.Put(1, "a")
+	calls := 0
+	load := func(key int) (string, error) {
+		calls++
+		if key < 0 {
+			return "", errors.New("load failed")
+		}
+		return strconv.Itoa(key), nil
 	}
-}
 
-func TestMapIteratorPrevOnEmpty(t *testing.T) {
-	m := NewWithStringComparator[int, int]()
-	it := m.Iterator()
-	it = m.Iterator()
-	for it.Prev() {
-		t.Errorf("Shouldn't iterate on empty map")
+	if actualValue, err := m.GetOrComputeErr(1, load); actualValue != "a" || err != nil || calls != 0 {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, err, "a", nil)
+	}
+	if actualValue, err := m.GetOrComputeErr(2, load); actualValue != "2" || err != nil || calls != 1 {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, err, "2", nil)
+	}
+	if actualValue, found := m.Get(2); actualValue != "2" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "2")
+	}
+	if _, err := m.GetOrComputeErr(-1, load); err == nil || err.Error() != "load failed" {
+		t.Errorf("Got %v expected %v", err, "load failed")
+	}
+	if _, found := m.Get(-1); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+	if actualValue := m.Size(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
 	}
 }
 
-func TestMapIteratorNext(t *testing.T) {
-	m := NewWithStringComparator[string, int]()
-	m.Put("c", 3)
-	m.Put("a", 1)
-	m.Put("b", 2)
+func TestMapReorder(t *testing.T) {
+	m := NewWithIntComparator[int, string]()
+	m.Put(3, "c")
+	m.Put(1, "a")
+	m.Put(2, "b")
 
-	it := m.Iterator()
-	count := 0
-	for it.Next() {
-		count++
-		key := it.Key()
-		value := it.Value()
-		switch key {
-		case "a":
-			if actualValue, expectedValue := value, 1; actualValue != expectedValue {
-				t.Errorf("Got %v expected %v", actualValue, expectedValue)
-			}
-		case "b":
-			if actualValue, expectedValue := value, 2; actualValue != expectedValue {
-				t.Errorf("Got %v expected %v", actualValue, expectedValue)
-			}
-		case "c":
-			if actualValue, expectedValue := value, 3; actualValue != expectedValue {
-				t.Errorf("Got %v expected %v", actualValue, expectedValue)
-			}
-		default:
-			t.Errorf("Too many")
-		}
-		if actualValue, expectedValue := value, count; actualValue != expectedValue {
-			t.Errorf("Got %v expected %v", actualValue, expectedValue)
-		}
+	descending := func(a, b interface{}) int { return utils.IntComparator(b, a) }
+	m.Reorder(descending)
+	if actualValue, expectedValue := fmt.Sprint(m.Keys()), "[3 2 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
 	}
-	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+	if actualValue, found := m.Get(2); actualValue != "b" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "b")
+	}
+	m.Put(4, "d")
+	if actualValue, expectedValue := fmt.Sprint(m.Values()), "[d c b a]"; actualValue != expectedValue {
 		t.Errorf("Got %v expected %v", actualValue, expectedValue)
 	}
-}
 
-func TestMapIteratorPrev(t *testing.T) {
-	m := NewWithStringComparator[string, int]()
-	m.Put("c", 3)
-	m.Put("a", 1)
-	m.Put("b", 2)
+	// already sorted under the new comparator
+	m.Reorder(descending)
+	if actualValue, expectedValue := fmt.Sprint(m.Keys()), "[4 3 2 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.Reorder(utils.IntComparator)
+	if actualValue, expectedValue := fmt.Sprint(m.Keys()), "[1 2 3 4]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
 
-	it := m.Iterator()
-	for it.Next() {
+	empty := NewWithIntComparator[int, string]()
+	empty.Reorder(descending)
+	if actualValue := empty.Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
 	}
-	countDown := m.Size()
-	for it.Prev() {
-		key := it.Key()
+}
+
+func TestMapPutIfAbsent(t *testing.T) {
+	m := NewWithIntComparator[int, string]()
+	m.Put(1, "a")
+
+	if actualValue, loaded := m.PutIfAbsent(1, "x"); actualValue != "a" || !loaded {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, loaded, "a", true)
+	}
+	if actualValue, loaded := m.PutIfAbsent(2, "b"); actualValue != "b" || loaded {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, loaded, "b", false)
+	}
+	if actualValue, expectedValue := fmt.Sprint(m.Keys(), m.Values()), "[1 2] [a b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapGetOrDefault(t *testing.T) {
+	m := NewWithIntComparator[int, string]()
+	m.Put(1, "a")
+
+	// key,expectedValue
+	tests := [][]interface{}{
+		{1, "a"},
+		{2, "z"},
+	}
+	for _, test := range tests {
+		if actualValue := m.GetOrDefault(test[0].(int), "z"); actualValue != test[1] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+	}
+	if actualValue := m.Size(); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+}
+
+func TestMapMergeCompute(t *testing.T) {
+	m := NewWithIntComparator[int, int]()
+	sum := func(old, new int) int { return old + new }
+	m.Merge(1, 5, sum)
+	m.Merge(1, 3, sum)
+	m.Merge(2, 1, sum)
+	if actualValue, expectedValue := fmt.Sprint(m.Keys(), m.Values()), "[1 2] [8 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// increments the value of a key, inserting absent keys with 1, but removes keys holding 1
+	increment := func(old int, found bool) (int, bool) {
+		if found && old == 1 {
+			return 0, false
+		}
+		return old + 1, true
+	}
+	m.Compute(1, increment)
+	m.Compute(2, increment)
+	m.Compute(3, increment)
+	if actualValue, expectedValue := fmt.Sprint(m.Keys(), m.Values()), "[1 3] [9 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	m.Compute(4, func(old int, found bool) (int, bool) {
+		if found || old != 0 {
+			t.Errorf("Got %v,%v expected %v,%v", old, found, 0, false)
+		}
+		return 0, false
+	})
+	if actualValue := m.Size(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+
+	m.SetCapacity(2, false)
+	m.Merge(5, 1, sum)
+	m.Compute(4, increment)
+	if actualValue, expectedValue := fmt.Sprint(m.Keys(), m.Values()), "[4 5] [1 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.Merge(5, 2, sum)
+	if actualValue, expectedValue := fmt.Sprint(m.Keys(), m.Values()), "[4 5] [1 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapClone(t *testing.T) {
+	m := NewWith[int, string](func(a, b interface{}) int { return utils.IntComparator(b, a) })
+	m.Put(2, "b")
+	m.Put(1, "a")
+
+	clone := m.Clone()
+	clone.Put(3, "c")
+	clone.Remove(1)
+	m.Put(2, "x")
+
+	if actualValue, expectedValue := fmt.Sprint(m.Keys(), m.Values()), "[2 1] [x a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(clone.Keys(), clone.Values()), "[3 2] [c b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, found := clone.Get(2); actualValue != "b" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "b")
+	}
+}
+
+func TestMapContainsSorted(t *testing.T) {
+	m := NewWithIntComparator[int, string]()
+	for _, key := range []int{7, 3, 5, 1} {
+		m.Put(key, "")
+	}
+	if actualValue, expectedValue := fmt.Sprint(m.ContainsSorted([]int{0, 1, 1, 2, 5, 7, 8})), "[false true true false true true false]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := m.ContainsSorted(nil); len(actualValue) != 0 {
+		t.Errorf("Got %v expected %v", actualValue, "[]")
+	}
+	if actualValue, expectedValue := fmt.Sprint(NewWithIntComparator[int, string]().ContainsSorted([]int{1, 2})), "[false false]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Got %v expected %v", r, "panic")
+		}
+	}()
+	m.ContainsSorted([]int{5, 3})
+}
+
+func TestMapSmallestLargestKeys(t *testing.T) {
+	m := NewWithIntComparator[int, string]()
+	if actualValue := m.SmallestKeys(3); len(actualValue) != 0 {
+		t.Errorf("Got %v expected %v", actualValue, "[]")
+	}
+	for _, key := range []int{5, 1, 4, 2, 3} {
+		m.Put(key, "")
+	}
+	// k,expectedSmallest,expectedLargest
+	tests := [][]interface{}{
+		{-1, "[]", "[]"},
+		{0, "[]", "[]"},
+		{2, "[1 2]", "[5 4]"},
+		{5, "[1 2 3 4 5]", "[5 4 3 2 1]"},
+		{9, "[1 2 3 4 5]", "[5 4 3 2 1]"},
+	}
+	for _, test := range tests {
+		if actualValue := fmt.Sprint(m.SmallestKeys(test[0].(int))); actualValue != test[1] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+		if actualValue := fmt.Sprint(m.LargestKeys(test[0].(int))); actualValue != test[2] {
+			t.Errorf("Got %v expected %v", actualValue, test[2])
+		}
+	}
+}
+
+func TestMapPollFirstLast(t *testing.T) {
+	m := NewWithIntComparator[int, string]()
+	if _, _, ok := m.PollFirst(); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	m.Put(2, "b")
+	m.Put(1, "a")
+	m.Put(3, "c")
+	if key, value, ok := m.PollFirst(); key != 1 || value != "a" || !ok {
+		t.Errorf("Got %v -> %v expected %v -> %v", key, value, 1, "a")
+	}
+	if key, value, ok := m.PollLast(); key != 3 || value != "c" || !ok {
+		t.Errorf("Got %v -> %v expected %v -> %v", key, value, 3, "c")
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapSetCapacity(t *testing.T) {
+	m := NewWithIntComparator[int, int]()
+	m.SetCapacity(3, false)
+	for i := 1; i <= 5; i++ {
+		m.Put(i, i)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[3 4 5]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.Put(4, 40) // overwrite does not evict
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[3 4 5]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	m.SetCapacity(2, true)
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[3 4]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.Put(1, 1)
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[1 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	m.SetCapacity(0, false)
+	for i := 10; i < 20; i++ {
+		m.Put(i, i)
+	}
+	if actualValue := m.Size(); actualValue != 12 {
+		t.Errorf("Got %v expected %v", actualValue, 12)
+	}
+}
+
+func TestMapMergeSorted(t *testing.T) {
+	m1 := NewWithIntComparator[int, string]()
+	m1.Put(1, "a1")
+	m1.Put(4, "d1")
+	m2 := NewWithIntComparator[int, string]()
+	m2.Put(2, "b2")
+	m2.Put(4, "d2")
+	m2.Put(6, "f2")
+	m3 := NewWithIntComparator[int, string]()
+	m3.Put(3, "c3")
+	m3.Put(4, "d3")
+
+	merged := MergeSorted[int, string](utils.IntComparator, m1, m2, NewWithIntComparator[int, string](), m3)
+	if actualValue, expectedValue := fmt.Sprintf("%v", merged), "TreeMap\nmap[1:a1 2:b2 3:c3 4:d3 6:f2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	merged = MergeSortedWith[int, string](utils.IntComparator, func(key int, existing, incoming string) string {
+		return existing + "+" + incoming
+	}, m1, m2, m3)
+	if actualValue, expectedValue := fmt.Sprintf("%v", merged), "TreeMap\nmap[1:a1 2:b2 3:c3 4:d1+d2+d3 6:f2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if actualValue := MergeSorted[int, string](utils.IntComparator).Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func TestMapDiff(t *testing.T) {
+	old := NewWithIntComparator[int, string]()
+	old.Put(1, "a")
+	old.Put(2, "b")
+	old.Put(3, "c")
+	old.Put(5, "e")
+	new := NewWithIntComparator[int, string]()
+	new.Put(2, "b")
+	new.Put(3, "x")
+	new.Put(4, "d")
+	new.Put(5, "y")
+	new.Put(6, "f")
+
+	added, removed, changed := Diff(old, new, nil)
+	if actualValue, expectedValue := fmt.Sprint(added), "[{4 d} {6 f}]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(removed), "[{1 a}]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(changed), "[{3 x} {5 y}]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	_, _, changed = Diff(old, new, func(a, b string) bool { return true })
+	if actualValue := len(changed); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	added, removed, changed = Diff(old, old, nil)
+	if actualValue := len(added) + len(removed) + len(changed); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func TestMapHistogram(t *testing.T) {
+	m := NewWith[float64, float64](utils.Float64Comparator)
+	m.Put(0.5, 1)
+	m.Put(9.9, 2)
+	m.Put(10, 4)
+	m.Put(25, 8)
+	m.Put(-0.5, 16)
+
+	histogram := Histogram(m, 10, func(key float64, value float64) (float64, float64) {
+		return key, value
+	})
+	if actualValue, expectedValue := fmt.Sprintf("%v", histogram), "map[-10:16 0:3 10:4 20:8]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapFind(t *testing.T) {
+	m := NewWithStringComparator[string, int]()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	foundKey, foundValue := m.Find(func(key string, value int) bool {
+		return key == "c"
+	})
+	if foundKey != "c" || foundValue != 3 {
+		t.Errorf("Got %v -> %v expected %v -> %v", foundKey, foundValue, "c", 3)
+	}
+	foundKey, foundValue = m.Find(func(key string, value int) bool {
+		return key == "x"
+	})
+	if foundKey != "" || foundValue != 0 {
+		t.Errorf("Got %v at %v expected %v at %v", foundValue, foundKey, nil, nil)
+	}
+}
+
+func TestMapChaining(t *testing.T) {
+	m := NewWithStringComparator[string, int]()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	chainedMap := m.Select(func(key string, value int) bool {
+		return value > 1
+	}).Map(func(key string, value int) (string, int) {
+		return key + key, value * value
+	})
+	if actualValue := chainedMap.Size(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, found := chainedMap.Get("aa"); actualValue != 0 || found {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue, found := chainedMap.Get("bb"); actualValue != 4 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	if actualValue, found := chainedMap.Get("cc"); actualValue != 9 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 9)
+	}
+}
+
+func TestMapIteratorNextOnEmpty(t *testing.T) {
+	m := NewWithStringComparator[int, int]()
+	it := m.Iterator()
+	it = m.Iterator()
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on empty map")
+	}
+}
+
+func TestMapIteratorPrevOnEmpty(t *testing.T) {
+	m := NewWithStringComparator[int, int]()
+	it := m.Iterator()
+	it = m.Iterator()
+	for it.Prev() {
+		t.Errorf("Shouldn't iterate on empty map")
+	}
+}
+
+func TestMapIteratorNext(t *testing.T) {
+	m := NewWithStringComparator[string, int]()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	it := m.Iterator()
+	count := 0
+	for it.Next() {
+		count++
+		key := it.Key()
+		value := it.Value()
+		switch key {
+		case "a":
+			if actualValue, expectedValue := value, 1; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case "b":
+			if actualValue, expectedValue := value, 2; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case "c":
+			if actualValue, expectedValue := value, 3; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		default:
+			t.Errorf("Too many")
+		}
+		if actualValue, expectedValue := value, count; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapIteratorPrev(t *testing.T) {
+	m := NewWithStringComparator[string, int]()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	it := m.Iterator()
+	for it.Next() {
+	}
+	countDown := m.Size()
+	for it.Prev() {
+		key := it.Key()
 		value := it.Value()
 		switch key {
 		case "a":
@@ -441,6 +1132,54 @@ func TestMapIteratorPrev(t *testing.T) {
 	}
 }
 
+func TestMapIteratorKeyValue(t *testing.T) {
+	m := NewWithIntComparator[int, string]()
+	m.Put(2, "b")
+	m.Put(1, "a")
+
+	it := m.Iterator()
+	expected := []containers.Entry[int, string]{{Key: 1, Value: "a"}, {Key: 2, Value: "b"}}
+	for i := 0; it.Next(); i++ {
+		if actualValue, expectedValue := it.KeyValue(), expected[i]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestMapIteratorResetDone(t *testing.T) {
+	m := NewWithIntComparator[int, string]()
+	m.Put(3, "c")
+	m.Put(1, "a")
+	m.Put(2, "b")
+	it := m.Iterator()
+	if actualValue := it.Done(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	it.Next()
+	it.Next()
+	if actualValue := it.Done(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	for it.Next() {
+	}
+	if actualValue := it.Done(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	it.Reset()
+	if actualValue := it.Done(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if !it.Next() || it.Key() != 1 {
+		t.Errorf("Got %v expected %v", it.Key(), 1)
+	}
+
+	it.Reset()
+	it.End()
+	if actualValue := it.Done(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
 func TestMapIteratorBegin(t *testing.T) {
 	m := NewWithIntComparator[int, string]()
 	it := m.Iterator()
@@ -498,6 +1237,278 @@ func TestMapIteratorLast(t *testing.T) {
 	}
 }
 
+func TestMapCursorAt(t *testing.T) {
+	m := NewWithIntComparator[int, string]()
+	if cursor, found := m.CursorAt(1); cursor != nil || found {
+		t.Errorf("Got %v,%v expected %v,%v", cursor, found, nil, false)
+	}
+	m.Put(5, "e")
+	m.Put(1, "a")
+	m.Put(3, "c")
+	m.Put(7, "g")
+
+	if cursor, found := m.CursorAt(4); cursor != nil || found {
+		t.Errorf("Got %v,%v expected %v,%v", cursor, found, nil, false)
+	}
+
+	cursor, found := m.CursorAt(3)
+	if !found {
+		t.Errorf("Got %v expected %v", found, true)
+	}
+	if key, value := cursor.Key(), cursor.Value(); key != 3 || value != "c" {
+		t.Errorf("Got %v,%v expected %v,%v", key, value, 3, "c")
+	}
+	if actualValue := cursor.Next(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if key, value := cursor.Key(), cursor.Value(); key != 5 || value != "e" {
+		t.Errorf("Got %v,%v expected %v,%v", key, value, 5, "e")
+	}
+	cursor.Next()
+	if actualValue := cursor.Next(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+
+	cursor, _ = m.CursorAt(3)
+	if actualValue := cursor.Prev(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if key, value := cursor.Key(), cursor.Value(); key != 1 || value != "a" {
+		t.Errorf("Got %v,%v expected %v,%v", key, value, 1, "a")
+	}
+	if actualValue := cursor.Prev(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestMapLowerUpperBound(t *testing.T) {
+	m := NewWithIntComparator[int, string]()
+	if cursor, found := m.LowerBound(1); cursor != nil || found {
+		t.Errorf("Got %v,%v expected %v,%v", cursor, found, nil, false)
+	}
+	if cursor, found := m.UpperBound(1); cursor != nil || found {
+		t.Errorf("Got %v,%v expected %v,%v", cursor, found, nil, false)
+	}
+	m.Put(10, "a")
+	m.Put(20, "b")
+	m.Put(30, "c")
+
+	// key,expectedLowerBound,expectedLowerFound,expectedUpperBound,expectedUpperFound
+	tests := [][]interface{}{
+		{5, 10, true, 10, true},
+		{10, 10, true, 20, true},
+		{15, 20, true, 20, true},
+		{20, 20, true, 30, true},
+		{30, 30, true, 0, false},
+		{35, 0, false, 0, false},
+	}
+
+	for _, test := range tests {
+		cursor, found := m.LowerBound(test[0].(int))
+		if found != test[2] || (found && cursor.Key() != test[1]) {
+			t.Errorf("Got %v expected %v,%v", found, test[1], test[2])
+		}
+		cursor, found = m.UpperBound(test[0].(int))
+		if found != test[4] || (found && cursor.Key() != test[3]) {
+			t.Errorf("Got %v expected %v,%v", found, test[3], test[4])
+		}
+	}
+
+	// sweep forward from the bound
+	cursor, _ := m.UpperBound(10)
+	keys := []int{cursor.Key()}
+	for cursor.Next() {
+		keys = append(keys, cursor.Key())
+	}
+	if actualValue, expectedValue := keys, []int{20, 30}; !sameElements(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapEachBatch(t *testing.T) {
+	m := NewWithIntComparator[int, string]()
+	m.Put(5, "e")
+	m.Put(1, "a")
+	m.Put(3, "c")
+	m.Put(2, "b")
+	m.Put(4, "d")
+
+	// size,expectedBatches
+	tests := [][]interface{}{
+		{2, "[[{1 a} {2 b}] [{3 c} {4 d}] [{5 e}]]"},
+		{5, "[[{1 a} {2 b} {3 c} {4 d} {5 e}]]"},
+		{9, "[[{1 a} {2 b} {3 c} {4 d} {5 e}]]"},
+		{0, "[[{1 a}] [{2 b}] [{3 c}] [{4 d}] [{5 e}]]"},
+	}
+	for _, test := range tests {
+		var batches [][]containers.Entry[int, string]
+		m.EachBatch(test[0].(int), func(batch []containers.Entry[int, string]) bool {
+			batches = append(batches, batch)
+			return true
+		})
+		if actualValue := fmt.Sprint(batches); actualValue != test[1] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+	}
+
+	calls := 0
+	m.EachBatch(2, func(batch []containers.Entry[int, string]) bool {
+		calls++
+		return false
+	})
+	if actualValue := calls; actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	NewWithIntComparator[int, string]().EachBatch(2, func(batch []containers.Entry[int, string]) bool {
+		t.Errorf("Got %v expected %v", batch, "no call")
+		return true
+	})
+}
+
+func TestMapRange(t *testing.T) {
+	m := NewWithIntComparator[int, string]()
+	m.Put(1, "a")
+	m.Put(3, "c")
+	m.Put(5, "e")
+	m.Put(7, "g")
+
+	// start,count,ascending,expected
+	tests := [][]interface{}{
+		{3, 2, true, "[{3 c} {5 e}]"},
+		{4, 2, true, "[{5 e} {7 g}]"},
+		{6, 5, true, "[{7 g}]"},
+		{8, 5, true, "[]"},
+		{5, 2, false, "[{5 e} {3 c}]"},
+		{4, 5, false, "[{3 c} {1 a}]"},
+		{0, 5, false, "[]"},
+		{3, 0, true, "[]"},
+		{3, -1, false, "[]"},
+	}
+
+	for _, test := range tests {
+		actualValue := fmt.Sprint(m.Range(test[0].(int), test[1].(int), test[2].(bool)))
+		if actualValue != test[3] {
+			t.Errorf("Got %v expected %v", actualValue, test[3])
+		}
+	}
+}
+
+func TestMapEachBetween(t *testing.T) {
+	m := NewWithIntComparator[int, string]()
+	m.Put(1, "a")
+	m.Put(3, "c")
+	m.Put(5, "e")
+	m.Put(7, "g")
+
+	// lo,hi,loInclusive,hiInclusive,expectedKeys
+	tests := [][]interface{}{
+		{3, 7, true, true, "[3 5 7]"},
+		{3, 7, false, true, "[5 7]"},
+		{3, 7, true, false, "[3 5]"},
+		{3, 7, false, false, "[5]"},
+		{2, 6, false, false, "[3 5]"},
+		{0, 9, true, true, "[1 3 5 7]"},
+		{7, 7, true, true, "[7]"},
+		{7, 7, false, true, "[]"},
+		{8, 9, true, true, "[]"},
+		{6, 2, true, true, "[]"},
+	}
+	for _, test := range tests {
+		keys := []int{}
+		m.EachBetween(test[0].(int), test[1].(int), test[2].(bool), test[3].(bool), func(key int, value string) bool {
+			keys = append(keys, key)
+			return true
+		})
+		if actualValue := fmt.Sprint(keys); actualValue != test[4] {
+			t.Errorf("Got %v expected %v", actualValue, test[4])
+		}
+	}
+
+	count := 0
+	m.EachBetween(0, 9, true, true, func(key int, value string) bool {
+		count++
+		return key < 3
+	})
+	if actualValue := count; actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+}
+
+func TestMapNextPrevN(t *testing.T) {
+	m := NewWithIntComparator[int, string]()
+	m.Put(1, "a")
+	m.Put(3, "c")
+	m.Put(5, "e")
+	m.Put(7, "g")
+
+	if actualValue, expectedValue := m.NextN(3, 2), []containers.Entry[int, string]{{Key: 5, Value: "e"}, {Key: 7, Value: "g"}}; fmt.Sprint(actualValue) != fmt.Sprint(expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.NextN(0, 2), []containers.Entry[int, string]{{Key: 1, Value: "a"}, {Key: 3, Value: "c"}}; fmt.Sprint(actualValue) != fmt.Sprint(expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.NextN(6, 5), []containers.Entry[int, string]{{Key: 7, Value: "g"}}; fmt.Sprint(actualValue) != fmt.Sprint(expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := m.NextN(7, 5); len(actualValue) != 0 {
+		t.Errorf("Got %v expected %v", actualValue, "[]")
+	}
+	if actualValue, expectedValue := m.PrevN(5, 5), []containers.Entry[int, string]{{Key: 3, Value: "c"}, {Key: 1, Value: "a"}}; fmt.Sprint(actualValue) != fmt.Sprint(expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.PrevN(8, 1), []containers.Entry[int, string]{{Key: 7, Value: "g"}}; fmt.Sprint(actualValue) != fmt.Sprint(expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := m.PrevN(1, 5); len(actualValue) != 0 {
+		t.Errorf("Got %v expected %v", actualValue, "[]")
+	}
+	if actualValue := m.NextN(0, 0); len(actualValue) != 0 {
+		t.Errorf("Got %v expected %v", actualValue, "[]")
+	}
+}
+
+func TestMapKeysValuesSeq(t *testing.T) {
+	m := NewWithIntComparator[int, string]()
+	for range m.KeysSeq() {
+		t.Errorf("Shouldn't iterate on empty map")
+	}
+	m.Put(3, "c")
+	m.Put(1, "a")
+	m.Put(2, "b")
+
+	keys := []int{}
+	for key := range m.KeysSeq() {
+		keys = append(keys, key)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", keys), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	values := []string{}
+	for value := range m.ValuesSeq() {
+		values = append(values, value)
+		if value == "b" {
+			break
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", values), "[a b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapFromJSONInvalid(t *testing.T) {
+	m := NewWithStringComparator[string, int]()
+	m.Put("a", 1)
+	for _, data := range []string{`{"b":2,"c":"x"}`, `{"b":2,`, `[1]`} {
+		if err := m.FromJSON([]byte(data)); err == nil {
+			t.Errorf("Expected error for %v", data)
+		}
+		if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[a]"; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
 func TestMapSerialization(t *testing.T) {
 	for i := 0; i < 10; i++ {
 		original := NewWithStringComparator[string, string]()
@@ -524,6 +1535,129 @@ func TestMapSerialization(t *testing.T) {
 	}
 }
 
+type idKey struct {
+	n int
+}
+
+func (key idKey) MarshalText() ([]byte, error) {
+	return []byte("id-" + strconv.Itoa(key.n)), nil
+}
+
+func (key *idKey) UnmarshalText(text []byte) error {
+	n, err := strconv.Atoi(strings.TrimPrefix(string(text), "id-"))
+	key.n = n
+	return err
+}
+
+func TestMapToJSONWithKeyFunc(t *testing.T) {
+	m := NewWithIntComparator[int, string]()
+	m.Put(10, "j")
+	m.Put(100, "x")
+	m.Put(2, "b")
+	json, err := m.ToJSONWithKeyFunc(func(key int) string {
+		return fmt.Sprintf("%03d", key)
+	})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(json), `{"002":"b","010":"j","100":"x"}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapSerializationTextKeys(t *testing.T) {
+	comparator := func(a, b interface{}) int {
+		return utils.IntComparator(a.(idKey).n, b.(idKey).n)
+	}
+	original := NewWith[idKey, string](comparator)
+	original.Put(idKey{2}, "b")
+	original.Put(idKey{1}, "a")
+
+	serialized, err := original.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(serialized), `{"id-1":"a","id-2":"b"}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	deserialized := NewWith[idKey, string](comparator)
+	if err := deserialized.FromJSON(serialized); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", deserialized.Keys()), "[{1} {2}]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapFromJSONStrict(t *testing.T) {
+	m := NewWithStringComparator[string, int]()
+	m.Put("z", 26)
+
+	if err := m.FromJSONStrict([]byte(`{"a":1,"b":2,"a":3}`)); err == nil || err.Error() != `duplicate key "a"` {
+		t.Errorf("Got %v expected %v", err, `duplicate key "a"`)
+	}
+	if actualValue, expectedValue := m.Keys(), []string{"z"}; !sameElements(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// only the keys of the top-level object are checked
+	nested := NewWithStringComparator[string, map[string]int]()
+	if err := nested.FromJSONStrict([]byte(`{"a":{"c":1,"c":2}}`)); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, found := nested.Get("a"); !found || actualValue["c"] != 2 {
+		t.Errorf("Got %v expected %v", actualValue, map[string]int{"c": 2})
+	}
+
+	if err := m.FromJSONStrict([]byte(`{"a":1,"b":2}`)); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := m.Keys(), []string{"a", "b"}; !sameElements(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := m.FromJSONStrict([]byte(`{"a":1,`)); err == nil {
+		t.Errorf("Expected error")
+	}
+}
+
+func TestMapSerializationTyped(t *testing.T) {
+	type point struct {
+		X, Y int
+	}
+
+	original := NewWithIntComparator[int, point]()
+	original.Put(3, point{3, 30})
+	original.Put(1, point{1, 10})
+	original.Put(2, point{2, 20})
+
+	serialized, err := original.ToJSONTyped()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(serialized), `[[1,{"X":1,"Y":10}],[2,{"X":2,"Y":20}],[3,{"X":3,"Y":30}]]`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	deserialized := NewWithIntComparator[int, point]()
+	if err = deserialized.FromJSONTyped(serialized); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := deserialized.Keys(), []int{1, 2, 3}; !sameElements(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := deserialized.Values(), []point{{1, 10}, {2, 20}, {3, 30}}; !sameElements(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if err = deserialized.FromJSONTyped([]byte(`[["a",{"X":1,"Y":10}]]`)); err == nil {
+		t.Errorf("Expected error")
+	}
+	if actualValue := deserialized.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+}
+
 //noinspection GoBoolExpressions
 func assertSerialization[T comparable, P any](m *Map[string, string], txt string, t *testing.T) {
 	if actualValue := m.Keys(); false ||
@@ -0,0 +1,22 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linkedlistqueue
+
+import "github.com/lemonyxk/gods/containers"
+
+func assertSerializationImplementation[T comparable]() {
+	var _ containers.JSONSerializer = (*Queue[T])(nil)
+	var _ containers.JSONDeserializer = (*Queue[T])(nil)
+}
+
+// ToJSON outputs the JSON representation of the queue.
+func (queue *Queue[T]) ToJSON() ([]byte, error) {
+	return queue.list.ToJSON()
+}
+
+// FromJSON populates the queue from the input JSON representation.
+func (queue *Queue[T]) FromJSON(data []byte) error {
+	return queue.list.FromJSON(data)
+}
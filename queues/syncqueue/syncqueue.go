@@ -0,0 +1,140 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package syncqueue implements a thread safe queue for producer/consumer use.
+//
+// It wraps a linkedlistqueue.Queue with a mutex and adds PollTimeout,
+// which blocks until an element is available or the timeout elapses.
+//
+// Structure is thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Queue_(abstract_data_type)
+package syncqueue
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lemonyxk/gods/queues"
+	"github.com/lemonyxk/gods/queues/linkedlistqueue"
+	"github.com/lemonyxk/gods/utils"
+)
+
+func assertQueueImplementation[T comparable]() {
+	var _ queues.Queue[T] = (*Queue[T])(nil)
+}
+
+// Queue holds a linked list queue guarded by a mutex
+type Queue[T comparable] struct {
+	queue *linkedlistqueue.Queue[T]
+	mutex sync.Mutex
+	cond  *sync.Cond
+}
+
+// New instantiates a new empty queue
+func New[T comparable]() *Queue[T] {
+	queue := &Queue[T]{queue: linkedlistqueue.New[T]()}
+	queue.cond = sync.NewCond(&queue.mutex)
+	return queue
+}
+
+// Enqueue adds a value to the end of the queue and wakes up a caller waiting in PollTimeout.
+func (queue *Queue[T]) Enqueue(value T) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	queue.queue.Enqueue(value)
+	queue.cond.Signal()
+}
+
+// Dequeue removes first element of the queue and returns it without blocking, or nil if queue is empty.
+// Second return parameter is true, unless the queue was empty and there was nothing to dequeue.
+func (queue *Queue[T]) Dequeue() (value T, ok bool) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	return queue.queue.Dequeue()
+}
+
+// dequeueWait removes first element of the queue and returns it, blocking until an element is available.
+// Returns the context's error if the context is done before an element could be dequeued.
+func (queue *Queue[T]) dequeueWait(ctx context.Context) (value T, err error) {
+	// wake up all waiters when the context is done, each of them rechecks its own context
+	stop := context.AfterFunc(ctx, func() {
+		queue.mutex.Lock()
+		defer queue.mutex.Unlock()
+		queue.cond.Broadcast()
+	})
+	defer stop()
+
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	for queue.queue.Empty() {
+		if err = ctx.Err(); err != nil {
+			return utils.AnyEmpty[T](), err
+		}
+		queue.cond.Wait()
+	}
+	// an available element is always taken, so a signal from Enqueue is never lost on a cancelled waiter
+	value, _ = queue.queue.Dequeue()
+	return value, nil
+}
+
+// PollTimeout removes first element of the queue and returns it, blocking for at most d until an element is available.
+// Second return parameter is false if the timeout elapsed before an element could be dequeued.
+// The timer is released on return, so no goroutine or timer outlives the call.
+func (queue *Queue[T]) PollTimeout(d time.Duration) (value T, ok bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), d)
+	defer cancel()
+	value, err := queue.dequeueWait(ctx)
+	return value, err == nil
+}
+
+// Peek returns first element of the queue without removing it, or nil if queue is empty.
+// Second return parameter is true, unless the queue was empty and there was nothing to peek.
+func (queue *Queue[T]) Peek() (value T, ok bool) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	return queue.queue.Peek()
+}
+
+// Empty returns true if queue does not contain any elements.
+func (queue *Queue[T]) Empty() bool {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	return queue.queue.Empty()
+}
+
+// Size returns number of elements within the queue.
+func (queue *Queue[T]) Size() int {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	return queue.queue.Size()
+}
+
+// Clear removes all elements from the queue.
+func (queue *Queue[T]) Clear() {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	queue.queue.Clear()
+}
+
+// Values returns all elements in the queue (FIFO order).
+func (queue *Queue[T]) Values() []T {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	return queue.queue.Values()
+}
+
+// String returns a string representation of container
+func (queue *Queue[T]) String() string {
+	str := "SyncQueue\n"
+	values := []string{}
+	for _, value := range queue.Values() {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syncqueue
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestQueueEnqueueDequeue(t *testing.T) {
+	queue := New[int]()
+	if _, ok := queue.Dequeue(); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	queue.Enqueue(3)
+	if actualValue := queue.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, ok := queue.Peek(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	for _, expectedValue := range []int{1, 2, 3} {
+		if actualValue, ok := queue.Dequeue(); actualValue != expectedValue || !ok {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue := queue.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestQueueDequeueWaitCancel(t *testing.T) {
+	queue := New[int]()
+	ctx, cancel := context.WithCancel(context.Background())
+	result := make(chan error)
+	go func() {
+		_, err := queue.dequeueWait(ctx)
+		result <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	if actualValue := <-result; actualValue != context.Canceled {
+		t.Errorf("Got %v expected %v", actualValue, context.Canceled)
+	}
+}
+
+func TestQueuePollTimeout(t *testing.T) {
+	queue := New[int]()
+	start := time.Now()
+	if _, ok := queue.PollTimeout(20 * time.Millisecond); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	if elapsed := time.Since(start); elapsed < 20*time.Millisecond {
+		t.Errorf("Returned after %v expected at least %v", elapsed, 20*time.Millisecond)
+	}
+
+	result := make(chan int)
+	go func() {
+		value, ok := queue.PollTimeout(5 * time.Second)
+		if !ok {
+			t.Errorf("Got %v expected %v", ok, true)
+		}
+		result <- value
+	}()
+	time.Sleep(10 * time.Millisecond)
+	queue.Enqueue(7)
+	if actualValue := <-result; actualValue != 7 {
+		t.Errorf("Got %v expected %v", actualValue, 7)
+	}
+
+	queue.Enqueue(8)
+	if actualValue, ok := queue.PollTimeout(0); actualValue != 8 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 8)
+	}
+}
+
+func TestQueueProducerConsumer(t *testing.T) {
+	queue := New[int]()
+	producers, consumers, count := 4, 4, 1000
+	var wg sync.WaitGroup
+	dequeued := make(chan int, producers*count)
+	for i := 0; i < consumers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				value, ok := queue.PollTimeout(500 * time.Millisecond)
+				if !ok {
+					return
+				}
+				dequeued <- value
+			}
+		}()
+	}
+	for i := 0; i < producers; i++ {
+		go func() {
+			for n := 0; n < count; n++ {
+				queue.Enqueue(n)
+			}
+		}()
+	}
+	wg.Wait()
+	if actualValue := len(dequeued); actualValue != producers*count {
+		t.Errorf("Got %v expected %v", actualValue, producers*count)
+	}
+	if actualValue := queue.Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func TestQueueString(t *testing.T) {
+	queue := New[int]()
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	if actualValue, expectedValue := queue.String(), "SyncQueue\n1, 2"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
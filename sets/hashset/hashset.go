@@ -22,7 +22,8 @@ func assertSetImplementation[T comparable]() {
 
 // Set holds elements in go's native map
 type Set[T comparable] struct {
-	items map[T]struct{}
+	items  map[T]struct{}
+	sealed bool
 }
 
 var itemExists = struct{}{}
@@ -36,8 +37,15 @@ func New[T comparable](values ...T) *Set[T] {
 	return set
 }
 
+// NewWithSize instantiates a new empty set with room for capacity items, e.g. before adding a known number of items in bulk.
+// A capacity that is not positive behaves like New.
+func NewWithSize[T comparable](capacity int) *Set[T] {
+	return &Set[T]{items: make(map[T]struct{}, max(capacity, 0))}
+}
+
 // Add adds the items (one or more) to the set.
 func (set *Set[T]) Add(items ...T) {
+	set.checkSealed()
 	for _, item := range items {
 		set.items[item] = itemExists
 	}
@@ -45,11 +53,26 @@ func (set *Set[T]) Add(items ...T) {
 
 // Remove removes the items (one or more) from the set.
 func (set *Set[T]) Remove(items ...T) {
+	set.checkSealed()
 	for _, item := range items {
 		delete(set.items, item)
 	}
 }
 
+// RemoveAll removes the items from the set and returns the number of items that were actually removed,
+// i.e. that were present in the set.
+func (set *Set[T]) RemoveAll(items ...T) int {
+	set.checkSealed()
+	removed := 0
+	for _, item := range items {
+		if _, contains := set.items[item]; contains {
+			delete(set.items, item)
+			removed++
+		}
+	}
+	return removed
+}
+
 // Contains check if items (one or more) are present in the set.
 // All items have to be present in the set for the method to return true.
 // Returns true if no arguments are passed at all, i.e. set is always superset of empty set.
@@ -62,6 +85,56 @@ func (set *Set[T]) Contains(items ...T) bool {
 	return true
 }
 
+// ContainsEach checks each of the items for presence in the set.
+// Returns a mask of the same length as items, where element i is true if items[i] is present in the set.
+func (set *Set[T]) ContainsEach(items []T) []bool {
+	mask := make([]bool, len(items))
+	for i, item := range items {
+		_, mask[i] = set.items[item]
+	}
+	return mask
+}
+
+// Equal returns true if the other set holds the same items, regardless of order.
+func (set *Set[T]) Equal(other *Set[T]) bool {
+	if set.Size() != other.Size() {
+		return false
+	}
+	for item := range other.items {
+		if _, contains := set.items[item]; !contains {
+			return false
+		}
+	}
+	return true
+}
+
+// IntersectionSize returns the number of items held by both sets, without building their intersection.
+// Iterates the smaller set and probes the larger one.
+func (set *Set[T]) IntersectionSize(other *Set[T]) int {
+	small, large := set, other
+	if small.Size() > large.Size() {
+		small, large = large, small
+	}
+	count := 0
+	for item := range small.items {
+		if _, contains := large.items[item]; contains {
+			count++
+		}
+	}
+	return count
+}
+
+// Jaccard returns the Jaccard similarity of the two sets, i.e. the size of their intersection divided by the size of their union.
+// Returns 0 if both sets are empty.
+func (set *Set[T]) Jaccard(other *Set[T]) float64 {
+	intersection := set.IntersectionSize(other)
+	union := set.Size() + other.Size() - intersection
+	if union == 0 {
+		return 0
+	}
+	return float64(intersection) / float64(union)
+}
+
 // Empty returns true if set does not contain any elements.
 func (set *Set[T]) Empty() bool {
 	return set.Size() == 0
@@ -74,6 +147,7 @@ func (set *Set[T]) Size() int {
 
 // Clear clears all values in the set.
 func (set *Set[T]) Clear() {
+	set.checkSealed()
 	set.items = make(map[T]struct{})
 }
 
@@ -88,6 +162,17 @@ func (set *Set[T]) Values() []T {
 	return values
 }
 
+// Seal makes the set immutable; later modifying calls panic with "container is frozen".
+// Reads remain allowed. Sealing is one-way.
+func (set *Set[T]) Seal() {
+	set.sealed = true
+}
+
+// Sealed returns true if the set has been sealed.
+func (set *Set[T]) Sealed() bool {
+	return set.sealed
+}
+
 // String returns a string representation of container
 func (set *Set[T]) String() string {
 	str := "HashSet\n"
@@ -98,3 +183,10 @@ func (set *Set[T]) String() string {
 	str += strings.Join(items, ", ")
 	return str
 }
+
+// checkSealed panics if the set has been sealed.
+func (set *Set[T]) checkSealed() {
+	if set.sealed {
+		panic("container is frozen")
+	}
+}
@@ -22,6 +22,7 @@ func (set *Set[T]) ToJSON() ([]byte, error) {
 
 // FromJSON populates the set from the input JSON representation.
 func (set *Set[T]) FromJSON(data []byte) error {
+	set.checkSealed()
 	elements := []T{}
 	err := json.Unmarshal(data, &elements)
 	if err == nil {
@@ -29,6 +29,7 @@ func assertSetImplementation[T comparable]() {
 type Set[T comparable] struct {
 	table    map[T]struct{}
 	ordering *doublylinkedlist.List[T]
+	sealed   bool
 }
 
 var itemExists = struct{}{}
@@ -48,6 +49,7 @@ func New[T comparable](values ...T) *Set[T] {
 // Add adds the items (one or more) to the set.
 // Note that insertion-order is not affected if an element is re-inserted into the set.
 func (set *Set[T]) Add(items ...T) {
+	set.checkSealed()
 	for _, item := range items {
 		if _, contains := set.table[item]; !contains {
 			set.table[item] = itemExists
@@ -59,6 +61,7 @@ func (set *Set[T]) Add(items ...T) {
 // Remove removes the items (one or more) from the set.
 // Slow operation, worst-case O(n^2).
 func (set *Set[T]) Remove(items ...T) {
+	set.checkSealed()
 	for _, item := range items {
 		if _, contains := set.table[item]; contains {
 			delete(set.table, item)
@@ -68,6 +71,31 @@ func (set *Set[T]) Remove(items ...T) {
 	}
 }
 
+// RemoveAll removes the items from the set and returns the number of items that were actually removed,
+// i.e. that were present in the set.
+// The insertion-order of the remaining items is rebuilt once in O(n), instead of searching it for every removed item.
+func (set *Set[T]) RemoveAll(items ...T) int {
+	set.checkSealed()
+	removed := 0
+	for _, item := range items {
+		if _, contains := set.table[item]; contains {
+			delete(set.table, item)
+			removed++
+		}
+	}
+	if removed > 0 {
+		kept := make([]T, 0, len(set.table))
+		for _, item := range set.ordering.Values() {
+			if _, contains := set.table[item]; contains {
+				kept = append(kept, item)
+			}
+		}
+		set.ordering.Clear()
+		set.ordering.Add(kept...)
+	}
+	return removed
+}
+
 // Contains check if items (one or more) are present in the set.
 // All items have to be present in the set for the method to return true.
 // Returns true if no arguments are passed at all, i.e. set is always superset of empty set.
@@ -80,6 +108,46 @@ func (set *Set[T]) Contains(items ...T) bool {
 	return true
 }
 
+// Equal returns true if the other set holds the same items, regardless of order.
+func (set *Set[T]) Equal(other *Set[T]) bool {
+	if set.Size() != other.Size() {
+		return false
+	}
+	for item := range other.table {
+		if _, contains := set.table[item]; !contains {
+			return false
+		}
+	}
+	return true
+}
+
+// IntersectionSize returns the number of items held by both sets, without building their intersection.
+// Iterates the smaller set and probes the larger one.
+func (set *Set[T]) IntersectionSize(other *Set[T]) int {
+	small, large := set, other
+	if small.Size() > large.Size() {
+		small, large = large, small
+	}
+	count := 0
+	for item := range small.table {
+		if _, contains := large.table[item]; contains {
+			count++
+		}
+	}
+	return count
+}
+
+// Jaccard returns the Jaccard similarity of the two sets, i.e. the size of their intersection divided by the size of their union.
+// Returns 0 if both sets are empty.
+func (set *Set[T]) Jaccard(other *Set[T]) float64 {
+	intersection := set.IntersectionSize(other)
+	union := set.Size() + other.Size() - intersection
+	if union == 0 {
+		return 0
+	}
+	return float64(intersection) / float64(union)
+}
+
 // Empty returns true if set does not contain any elements.
 func (set *Set[T]) Empty() bool {
 	return set.Size() == 0
@@ -92,6 +160,7 @@ func (set *Set[T]) Size() int {
 
 // Clear clears all values in the set.
 func (set *Set[T]) Clear() {
+	set.checkSealed()
 	set.table = make(map[T]struct{})
 	set.ordering.Clear()
 }
@@ -106,6 +175,24 @@ func (set *Set[T]) Values() []T {
 	return values
 }
 
+// Snapshot returns the current items in insertion-order as a fresh slice.
+// The slice is owned by the caller and is not affected by later modifications of the set,
+// so it can be iterated freely while the set is modified, e.g. by other goroutines under the caller's own lock.
+func (set *Set[T]) Snapshot() []T {
+	return set.ordering.Values()
+}
+
+// Seal makes the set immutable; later modifying calls panic with "container is frozen".
+// Reads remain allowed. Sealing is one-way.
+func (set *Set[T]) Seal() {
+	set.sealed = true
+}
+
+// Sealed returns true if the set has been sealed.
+func (set *Set[T]) Sealed() bool {
+	return set.sealed
+}
+
 // String returns a string representation of container
 func (set *Set[T]) String() string {
 	str := "LinkedHashSet\n"
@@ -117,3 +204,10 @@ func (set *Set[T]) String() string {
 	str += strings.Join(items, ", ")
 	return str
 }
+
+// checkSealed panics if the set has been sealed.
+func (set *Set[T]) checkSealed() {
+	if set.sealed {
+		panic("container is frozen")
+	}
+}
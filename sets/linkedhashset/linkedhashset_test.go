@@ -38,6 +38,83 @@ func TestSetAdd(t *testing.T) {
 	}
 }
 
+func TestSetEqual(t *testing.T) {
+	set := New[int](1, 2, 3)
+	tests := [][]interface{}{
+		{New[int](3, 1, 2), true},
+		{New[int](1, 2), false},
+		{New[int](1, 2, 4), false},
+		{New[int](), false},
+	}
+	for _, test := range tests {
+		if actualValue := set.Equal(test[0].(*Set[int])); actualValue != test[1] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+	}
+}
+
+func TestSetSnapshot(t *testing.T) {
+	set := New[string]("c", "a", "b")
+	snapshot := set.Snapshot()
+	set.Remove("a")
+	set.Add("d")
+	if actualValue, expectedValue := fmt.Sprintf("%v", snapshot), "[c a b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", set.Snapshot()), "[c b d]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSetIntersectionSizeJaccard(t *testing.T) {
+	set := New[int](1, 2, 3, 4)
+	// other,expectedIntersectionSize,expectedJaccard
+	tests := [][]interface{}{
+		{New[int](3, 4, 5, 6), 2, 2.0 / 6},
+		{New[int](4, 3, 2, 1), 4, 1.0},
+		{New[int](1), 1, 0.25},
+		{New[int](7, 8), 0, 0.0},
+		{New[int](), 0, 0.0},
+	}
+	for _, test := range tests {
+		other := test[0].(*Set[int])
+		if actualValue := set.IntersectionSize(other); actualValue != test[1] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+		if actualValue := other.IntersectionSize(set); actualValue != test[1] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+		if actualValue := set.Jaccard(other); actualValue != test[2] {
+			t.Errorf("Got %v expected %v", actualValue, test[2])
+		}
+	}
+	if actualValue := New[int]().Jaccard(New[int]()); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func TestSetRemoveAll(t *testing.T) {
+	set := New[int](1, 2, 3, 4, 5)
+	if actualValue := set.RemoveAll(2, 4, 6, 2); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue := set.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue := set.Contains(1, 3, 5); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, expectedValue := fmt.Sprint(set.Values()), "[1 3 5]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := set.RemoveAll(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue := set.RemoveAll(7); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
 func TestSetContains(t *testing.T) {
 	set := New[int]()
 	set.Add(3, 1, 2)
@@ -382,6 +459,32 @@ func benchmarkRemove(b *testing.B, set *Set[int], size int) {
 	}
 }
 
+func TestSetSeal(t *testing.T) {
+	set := New[int](1, 2, 3)
+	set.Seal()
+	if actualValue := set.Sealed(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	assertFrozenPanic(t, func() { set.Add(4) })
+	assertFrozenPanic(t, func() { set.Remove(1) })
+	assertFrozenPanic(t, func() { set.RemoveAll(1) })
+	assertFrozenPanic(t, func() { set.Clear() })
+	assertFrozenPanic(t, func() { set.FromJSON([]byte(`[4]`)) })
+	if actualValue, expectedValue := fmt.Sprint(set.Values()), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+// assertFrozenPanic asserts that f panics because the container is frozen.
+func assertFrozenPanic(t *testing.T, f func()) {
+	defer func() {
+		if r := recover(); r != "container is frozen" {
+			t.Errorf("Got %v expected %v", r, "container is frozen")
+		}
+	}()
+	f()
+}
+
 func BenchmarkHashSetContains100(b *testing.B) {
 	b.StopTimer()
 	size := 100
@@ -0,0 +1,157 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package multiset implements a multiset (bag) backed by a hash map of element counts.
+//
+// Unlike a set, a multiset can hold an element more than once and tracks how often each element occurs.
+//
+// Structure is not thread safe.
+//
+// References: https://en.wikipedia.org/wiki/Multiset
+package multiset
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lemonyxk/gods/containers"
+	"github.com/lemonyxk/gods/maps/hashmap"
+)
+
+func assertContainerImplementation[T comparable]() {
+	var _ containers.Container[T] = (*Multiset[T])(nil)
+}
+
+// Multiset holds the count of each element in a hash map
+type Multiset[T comparable] struct {
+	counts *hashmap.Map[T, int]
+	total  int
+}
+
+// New instantiates a new empty multiset and adds the passed values, if any, once per occurrence
+func New[T comparable](values ...T) *Multiset[T] {
+	multiset := &Multiset[T]{counts: hashmap.New[T, int]()}
+	for _, value := range values {
+		multiset.Add(value, 1)
+	}
+	return multiset
+}
+
+// Add adds count occurrences of the item to the multiset.
+// Does nothing if count is not positive.
+func (multiset *Multiset[T]) Add(item T, count int) {
+	if count <= 0 {
+		return
+	}
+	current, _ := multiset.counts.Get(item)
+	multiset.counts.Put(item, current+count)
+	multiset.total += count
+}
+
+// Remove removes count occurrences of the item from the multiset.
+// Removing more occurrences than present clamps the count at zero, i.e. removes the item.
+// Does nothing if count is not positive.
+func (multiset *Multiset[T]) Remove(item T, count int) {
+	if count <= 0 {
+		return
+	}
+	current, found := multiset.counts.Get(item)
+	if !found {
+		return
+	}
+	if count >= current {
+		multiset.counts.Remove(item)
+		multiset.total -= current
+		return
+	}
+	multiset.counts.Put(item, current-count)
+	multiset.total -= count
+}
+
+// Count returns the number of occurrences of the item, or 0 if it is not present.
+func (multiset *Multiset[T]) Count(item T) int {
+	count, _ := multiset.counts.Get(item)
+	return count
+}
+
+// Contains check if items (one or more) are present in the multiset at least once.
+// Returns true if no arguments are passed at all.
+func (multiset *Multiset[T]) Contains(items ...T) bool {
+	for _, item := range items {
+		if _, found := multiset.counts.Get(item); !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Distinct returns the unique elements of the multiset (random order).
+func (multiset *Multiset[T]) Distinct() []T {
+	return multiset.counts.Keys()
+}
+
+// TotalSize returns the sum of the counts of all elements.
+func (multiset *Multiset[T]) TotalSize() int {
+	return multiset.total
+}
+
+// Sum returns a new multiset in which the count of each element is the sum of its counts in both multisets.
+func (multiset *Multiset[T]) Sum(other *Multiset[T]) *Multiset[T] {
+	result := New[T]()
+	for _, item := range multiset.counts.Keys() {
+		result.Add(item, multiset.Count(item))
+	}
+	for _, item := range other.counts.Keys() {
+		result.Add(item, other.Count(item))
+	}
+	return result
+}
+
+// Difference returns a new multiset in which the count of each element is its count in this multiset
+// minus its count in the other multiset, clamped at zero.
+func (multiset *Multiset[T]) Difference(other *Multiset[T]) *Multiset[T] {
+	result := New[T]()
+	for _, item := range multiset.counts.Keys() {
+		result.Add(item, multiset.Count(item)-other.Count(item))
+	}
+	return result
+}
+
+// Empty returns true if multiset does not contain any elements.
+func (multiset *Multiset[T]) Empty() bool {
+	return multiset.total == 0
+}
+
+// Size returns number of elements within the multiset counting every occurrence, i.e. the same as TotalSize.
+func (multiset *Multiset[T]) Size() int {
+	return multiset.total
+}
+
+// Clear removes all elements.
+func (multiset *Multiset[T]) Clear() {
+	multiset.counts.Clear()
+	multiset.total = 0
+}
+
+// Values returns all elements, each repeated as often as it occurs (random order of distinct elements).
+func (multiset *Multiset[T]) Values() []T {
+	values := make([]T, 0, multiset.total)
+	for _, item := range multiset.counts.Keys() {
+		for count := multiset.Count(item); count > 0; count-- {
+			values = append(values, item)
+		}
+	}
+	return values
+}
+
+// String returns a string representation of container
+func (multiset *Multiset[T]) String() string {
+	str := "Multiset\n"
+	items := []string{}
+	for _, item := range multiset.counts.Keys() {
+		items = append(items, fmt.Sprintf("%v:%v", item, multiset.Count(item)))
+	}
+	str += strings.Join(items, ", ")
+	return str
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package multiset
+
+import (
+	"testing"
+)
+
+func TestMultisetNew(t *testing.T) {
+	multiset := New[string]("a", "b", "a")
+
+	if actualValue := multiset.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue := len(multiset.Distinct()); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue := multiset.Count("a"); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue := multiset.Count("c"); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func TestMultisetAddRemove(t *testing.T) {
+	multiset := New[int]()
+	multiset.Add(1, 3)
+	multiset.Add(2, 1)
+	multiset.Add(3, 0)
+	multiset.Add(3, -2)
+
+	if actualValue := multiset.TotalSize(); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	if actualValue := multiset.Contains(1, 2); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := multiset.Contains(3); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+
+	multiset.Remove(1, 2)
+	if actualValue := multiset.Count(1); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	multiset.Remove(2, 5)
+	if actualValue := multiset.Count(2); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue := multiset.Contains(2); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	multiset.Remove(4, 1)
+	multiset.Remove(1, -1)
+	if actualValue := multiset.TotalSize(); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue := len(multiset.Values()); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+
+	multiset.Clear()
+	if actualValue := multiset.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestMultisetSumDifference(t *testing.T) {
+	a := New[string]("x", "x", "x", "y")
+	b := New[string]("x", "y", "y", "z")
+
+	sum := a.Sum(b)
+	// item,expectedCount
+	tests := [][]interface{}{
+		{"x", 4},
+		{"y", 3},
+		{"z", 1},
+	}
+	for _, test := range tests {
+		if actualValue := sum.Count(test[0].(string)); actualValue != test[1] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+	}
+	if actualValue := sum.TotalSize(); actualValue != 8 {
+		t.Errorf("Got %v expected %v", actualValue, 8)
+	}
+
+	difference := a.Difference(b)
+	tests = [][]interface{}{
+		{"x", 2},
+		{"y", 0},
+		{"z", 0},
+	}
+	for _, test := range tests {
+		if actualValue := difference.Count(test[0].(string)); actualValue != test[1] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+	}
+	if actualValue := len(difference.Distinct()); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue := a.TotalSize(); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+}
+
+func TestMultisetString(t *testing.T) {
+	multiset := New[int](1, 1)
+	if actualValue, expectedValue := multiset.String(), "Multiset\n1:2"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
@@ -24,12 +24,14 @@ func assertSetImplementation[T comparable]() {
 
 // Set holds elements in a red-black tree
 type Set[T comparable] struct {
-	tree *rbt.Tree[T, T]
+	tree   *rbt.Tree[T, T]
+	sealed bool
 }
 
 var itemExists = struct{}{}
 
 // NewWith instantiates a new empty set with the custom comparator.
+// Panics if comparator is nil.
 func NewWith[T comparable](comparator utils.Comparator, values ...T) *Set[T] {
 	set := &Set[T]{tree: rbt.NewWith[T, T](comparator)}
 	if len(values) > 0 {
@@ -58,6 +60,7 @@ func NewWithStringComparator[T comparable](values ...T) *Set[T] {
 
 // Add adds the items (one or more) to the set.
 func (set *Set[T]) Add(items ...T) {
+	set.checkSealed()
 	for _, item := range items {
 		set.tree.Put(item, utils.AnyEmpty[T]())
 	}
@@ -65,6 +68,7 @@ func (set *Set[T]) Add(items ...T) {
 
 // Remove removes the items (one or more) from the set.
 func (set *Set[T]) Remove(items ...T) {
+	set.checkSealed()
 	for _, item := range items {
 		set.tree.Remove(item)
 	}
@@ -82,6 +86,47 @@ func (set *Set[T]) Contains(items ...T) bool {
 	return true
 }
 
+// Equal returns true if the other set holds the same items, regardless of order.
+func (set *Set[T]) Equal(other *Set[T]) bool {
+	if set.Size() != other.Size() {
+		return false
+	}
+	for _, item := range other.Values() {
+		if !set.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// IntersectionSize returns the number of items held by both sets, without building their intersection.
+// Iterates the smaller set and probes the larger one.
+func (set *Set[T]) IntersectionSize(other *Set[T]) int {
+	small, large := set, other
+	if small.Size() > large.Size() {
+		small, large = large, small
+	}
+	count := 0
+	it := small.tree.Iterator()
+	for it.Next() {
+		if _, contains := large.tree.Get(it.Key()); contains {
+			count++
+		}
+	}
+	return count
+}
+
+// Jaccard returns the Jaccard similarity of the two sets, i.e. the size of their intersection divided by the size of their union.
+// Returns 0 if both sets are empty.
+func (set *Set[T]) Jaccard(other *Set[T]) float64 {
+	intersection := set.IntersectionSize(other)
+	union := set.Size() + other.Size() - intersection
+	if union == 0 {
+		return 0
+	}
+	return float64(intersection) / float64(union)
+}
+
 // Empty returns true if set does not contain any elements.
 func (set *Set[T]) Empty() bool {
 	return set.tree.Size() == 0
@@ -94,6 +139,7 @@ func (set *Set[T]) Size() int {
 
 // Clear clears all values in the set.
 func (set *Set[T]) Clear() {
+	set.checkSealed()
 	set.tree.Clear()
 }
 
@@ -102,6 +148,17 @@ func (set *Set[T]) Values() []T {
 	return set.tree.Keys()
 }
 
+// Seal makes the set immutable; later modifying calls panic with "container is frozen".
+// Reads remain allowed. Sealing is one-way.
+func (set *Set[T]) Seal() {
+	set.sealed = true
+}
+
+// Sealed returns true if the set has been sealed.
+func (set *Set[T]) Sealed() bool {
+	return set.sealed
+}
+
 // String returns a string representation of container
 func (set *Set[T]) String() string {
 	str := "TreeSet\n"
@@ -112,3 +169,10 @@ func (set *Set[T]) String() string {
 	str += strings.Join(items, ", ")
 	return str
 }
+
+// checkSealed panics if the set has been sealed.
+func (set *Set[T]) checkSealed() {
+	if set.sealed {
+		panic("container is frozen")
+	}
+}
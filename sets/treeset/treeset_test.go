@@ -43,6 +43,48 @@ func TestSetAdd(t *testing.T) {
 	}
 }
 
+func TestSetEqual(t *testing.T) {
+	set := NewWithIntComparator[int](1, 2, 3)
+	tests := [][]interface{}{
+		{NewWithIntComparator[int](3, 1, 2), true},
+		{NewWithIntComparator[int](1, 2), false},
+		{NewWithIntComparator[int](1, 2, 4), false},
+		{NewWithIntComparator[int](), false},
+	}
+	for _, test := range tests {
+		if actualValue := set.Equal(test[0].(*Set[int])); actualValue != test[1] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+	}
+}
+
+func TestSetIntersectionSizeJaccard(t *testing.T) {
+	set := NewWithIntComparator[int](1, 2, 3, 4)
+	// other,expectedIntersectionSize,expectedJaccard
+	tests := [][]interface{}{
+		{NewWithIntComparator[int](3, 4, 5, 6), 2, 2.0 / 6},
+		{NewWithIntComparator[int](4, 3, 2, 1), 4, 1.0},
+		{NewWithIntComparator[int](1), 1, 0.25},
+		{NewWithIntComparator[int](7, 8), 0, 0.0},
+		{NewWithIntComparator[int](), 0, 0.0},
+	}
+	for _, test := range tests {
+		other := test[0].(*Set[int])
+		if actualValue := set.IntersectionSize(other); actualValue != test[1] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+		if actualValue := other.IntersectionSize(set); actualValue != test[1] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+		if actualValue := set.Jaccard(other); actualValue != test[2] {
+			t.Errorf("Got %v expected %v", actualValue, test[2])
+		}
+	}
+	if actualValue := NewWithIntComparator[int]().Jaccard(NewWithIntComparator[int]()); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
 func TestSetContains(t *testing.T) {
 	set := NewWithIntComparator[int]()
 	set.Add(3, 1, 2)
@@ -393,6 +435,31 @@ func benchmarkRemove(b *testing.B, set *Set[int], size int) {
 	}
 }
 
+func TestSetSeal(t *testing.T) {
+	set := NewWithIntComparator[int](1, 2, 3)
+	set.Seal()
+	if actualValue := set.Sealed(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	assertFrozenPanic(t, func() { set.Add(4) })
+	assertFrozenPanic(t, func() { set.Remove(1) })
+	assertFrozenPanic(t, func() { set.Clear() })
+	assertFrozenPanic(t, func() { set.FromJSON([]byte(`[4]`)) })
+	if actualValue, expectedValue := fmt.Sprint(set.Values()), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+// assertFrozenPanic asserts that f panics because the container is frozen.
+func assertFrozenPanic(t *testing.T, f func()) {
+	defer func() {
+		if r := recover(); r != "container is frozen" {
+			t.Errorf("Got %v expected %v", r, "container is frozen")
+		}
+	}()
+	f()
+}
+
 func BenchmarkTreeSetContains100(b *testing.B) {
 	b.StopTimer()
 	size := 100
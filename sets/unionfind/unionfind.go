@@ -0,0 +1,147 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package unionfind implements a disjoint-set (union-find) structure with path compression and union by rank.
+//
+// Elements are partitioned into disjoint sets, each identified by a representative element.
+// Find, Union and Connected run in nearly constant amortized time.
+//
+// Structure is not thread safe.
+//
+// References: https://en.wikipedia.org/wiki/Disjoint-set_data_structure
+package unionfind
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lemonyxk/gods/containers"
+)
+
+func assertContainerImplementation[T comparable]() {
+	var _ containers.Container[T] = (*UnionFind[T])(nil)
+}
+
+// UnionFind holds the parent and rank of each element in go's native maps
+type UnionFind[T comparable] struct {
+	parent map[T]T
+	rank   map[T]int
+	count  int
+}
+
+// New instantiates a new disjoint-set structure and makes a singleton set of each passed value, if any.
+func New[T comparable](values ...T) *UnionFind[T] {
+	uf := &UnionFind[T]{parent: make(map[T]T), rank: make(map[T]int)}
+	for _, value := range values {
+		uf.MakeSet(value)
+	}
+	return uf
+}
+
+// MakeSet adds the element as a new singleton set.
+// Does nothing if the element is already present.
+func (uf *UnionFind[T]) MakeSet(x T) {
+	if _, ok := uf.parent[x]; ok {
+		return
+	}
+	uf.parent[x] = x
+	uf.rank[x] = 0
+	uf.count++
+}
+
+// Find returns the representative element of the set containing x.
+// Returns x itself if x is not present, i.e. an unknown element is its own singleton set.
+func (uf *UnionFind[T]) Find(x T) T {
+	root, ok := uf.parent[x]
+	if !ok {
+		return x
+	}
+	for root != uf.parent[root] {
+		root = uf.parent[root]
+	}
+	// path compression
+	for x != root {
+		next := uf.parent[x]
+		uf.parent[x] = root
+		x = next
+	}
+	return root
+}
+
+// Union merges the sets containing x and y.
+// Elements which are not present are added as singleton sets before merging.
+func (uf *UnionFind[T]) Union(x, y T) {
+	uf.MakeSet(x)
+	uf.MakeSet(y)
+	rootX := uf.Find(x)
+	rootY := uf.Find(y)
+	if rootX == rootY {
+		return
+	}
+	// union by rank
+	switch {
+	case uf.rank[rootX] < uf.rank[rootY]:
+		uf.parent[rootX] = rootY
+	case uf.rank[rootX] > uf.rank[rootY]:
+		uf.parent[rootY] = rootX
+	default:
+		uf.parent[rootY] = rootX
+		uf.rank[rootX]++
+	}
+	uf.count--
+}
+
+// Connected returns true if x and y belong to the same set.
+func (uf *UnionFind[T]) Connected(x, y T) bool {
+	return uf.Find(x) == uf.Find(y)
+}
+
+// Count returns number of disjoint sets.
+func (uf *UnionFind[T]) Count() int {
+	return uf.count
+}
+
+// Empty returns true if structure does not contain any elements.
+func (uf *UnionFind[T]) Empty() bool {
+	return uf.Size() == 0
+}
+
+// Size returns number of elements within all sets.
+func (uf *UnionFind[T]) Size() int {
+	return len(uf.parent)
+}
+
+// Clear removes all elements.
+func (uf *UnionFind[T]) Clear() {
+	uf.parent = make(map[T]T)
+	uf.rank = make(map[T]int)
+	uf.count = 0
+}
+
+// Values returns all elements (random order).
+func (uf *UnionFind[T]) Values() []T {
+	values := make([]T, uf.Size())
+	count := 0
+	for value := range uf.parent {
+		values[count] = value
+		count++
+	}
+	return values
+}
+
+// String returns a string representation of container
+func (uf *UnionFind[T]) String() string {
+	str := "UnionFind\n"
+	groups := make(map[T][]string)
+	for value := range uf.parent {
+		root := uf.Find(value)
+		groups[root] = append(groups[root], fmt.Sprintf("%v", value))
+	}
+	items := []string{}
+	for _, group := range groups {
+		items = append(items, "{"+strings.Join(group, ", ")+"}")
+	}
+	str += strings.Join(items, ", ")
+	return str
+}
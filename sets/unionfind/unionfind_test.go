@@ -0,0 +1,109 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unionfind
+
+import (
+	"testing"
+)
+
+func TestUnionFindNew(t *testing.T) {
+	uf := New[int](1, 2, 3, 3)
+
+	if actualValue := uf.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue := uf.Count(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue := uf.Connected(1, 2); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := uf.Find(4); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	if actualValue := uf.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+}
+
+func TestUnionFindUnion(t *testing.T) {
+	uf := New[string]()
+	uf.Union("a", "b")
+	uf.Union("c", "d")
+	uf.Union("e", "e")
+
+	if actualValue := uf.Size(); actualValue != 5 {
+		t.Errorf("Got %v expected %v", actualValue, 5)
+	}
+	if actualValue := uf.Count(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+
+	tests := [][]interface{}{
+		{"a", "b", true},
+		{"c", "d", true},
+		{"a", "c", false},
+		{"b", "e", false},
+		{"e", "e", true},
+	}
+	for _, test := range tests {
+		if actualValue := uf.Connected(test[0].(string), test[1].(string)); actualValue != test[2] {
+			t.Errorf("Got %v expected %v", actualValue, test[2])
+		}
+	}
+
+	uf.Union("b", "d")
+	uf.Union("a", "c") // already connected
+	if actualValue := uf.Count(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue := uf.Connected("a", "d"); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, expectedValue := uf.Find("a"), uf.Find("c"); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestUnionFindPathCompression(t *testing.T) {
+	uf := New[int]()
+	for i := 1; i < 1000; i++ {
+		uf.Union(i-1, i)
+	}
+	if actualValue := uf.Count(); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	root := uf.Find(999)
+	for i := 0; i < 1000; i++ {
+		uf.Find(i)
+		if actualValue := uf.parent[i]; actualValue != root {
+			t.Errorf("Got %v expected %v", actualValue, root)
+		}
+	}
+}
+
+func TestUnionFindClear(t *testing.T) {
+	uf := New[int](1, 2)
+	uf.Union(1, 2)
+	uf.Clear()
+	if actualValue := uf.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := uf.Count(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue := len(uf.Values()); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func BenchmarkUnionFindUnion10000(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		uf := New[int]()
+		for n := 1; n < 10000; n++ {
+			uf.Union(n-1, n)
+		}
+	}
+}
@@ -167,6 +167,9 @@ func TestStackIteratorBegin(t *testing.T) {
 	for it.Next() {
 	}
 	it.Begin()
+	if value := it.Value(); value != "" {
+		t.Errorf("Got %v expected %v", value, "")
+	}
 	it.Next()
 	if index, value := it.Index(), it.Value(); index != 0 || value != "c" {
 		t.Errorf("Got %v,%v expected %v,%v", index, value, 0, "c")
@@ -193,6 +196,9 @@ func TestStackIteratorEnd(t *testing.T) {
 	if index := it.Index(); index != stack.Size() {
 		t.Errorf("Got %v expected %v", index, stack.Size())
 	}
+	if value := it.Value(); value != "" {
+		t.Errorf("Got %v expected %v", value, "")
+	}
 
 	it.Prev()
 	if index, value := it.Index(), it.Value(); index != stack.Size()-1 || value != "a" {
@@ -4,7 +4,10 @@
 
 package linkedliststack
 
-import "github.com/lemonyxk/gods/containers"
+import (
+	"github.com/lemonyxk/gods/containers"
+	"github.com/lemonyxk/gods/utils"
+)
 
 func assertIteratorImplementation[T comparable]() {
 	var _ containers.IteratorWithIndex[T] = (*Iterator[T])(nil)
@@ -32,9 +35,12 @@ func (iterator *Iterator[T]) Next() bool {
 	return iterator.stack.withinRange(iterator.index)
 }
 
-// Value returns the current element's value.
+// Value returns the current element's value, or nil if the iterator is not on an element.
 // Does not modify the state of the iterator.
 func (iterator *Iterator[T]) Value() T {
+	if !iterator.stack.withinRange(iterator.index) {
+		return utils.AnyEmpty[T]()
+	}
 	value, _ := iterator.stack.list.Get(iterator.index) // in reverse (LIFO)
 	return value
 }
@@ -36,6 +36,21 @@ func (stack *Stack[T]) Push(value T) {
 	stack.list.Prepend(value)
 }
 
+// PushBounded adds a value onto the top of the stack and, if the stack then holds more than max elements,
+// removes the bottom (oldest) element and returns it.
+// Second return parameter is true if an element was dropped from the bottom of the stack.
+// Dropping the bottom element requires walking the underlying singly-linked list, i.e. O(n).
+func (stack *Stack[T]) PushBounded(value T, max int) (dropped T, didDrop bool) {
+	stack.Push(value)
+	if stack.list.Size() <= max {
+		return
+	}
+	lastIndex := stack.list.Size() - 1
+	dropped, didDrop = stack.list.Get(lastIndex)
+	stack.list.Remove(lastIndex)
+	return
+}
+
 // Pop removes top element on stack and returns it, or nil if stack is empty.
 // Second return parameter is true, unless the stack was empty and there was nothing to pop.
 func (stack *Stack[T]) Pop() (value T, ok bool) {
@@ -50,6 +65,12 @@ func (stack *Stack[T]) Peek() (value T, ok bool) {
 	return stack.list.Get(0)
 }
 
+// PeekBottom returns bottom (oldest) element on the stack without removing it, or nil if stack is empty.
+// Second return parameter is true, unless the stack was empty and there was nothing to peek.
+func (stack *Stack[T]) PeekBottom() (value T, ok bool) {
+	return stack.list.Get(stack.list.Size() - 1)
+}
+
 // Empty returns true if stack does not contain any elements.
 func (stack *Stack[T]) Empty() bool {
 	return stack.list.Empty()
@@ -73,6 +73,41 @@ func TestStackPop(t *testing.T) {
 	}
 }
 
+func TestStackPeekBottom(t *testing.T) {
+	stack := New[int]()
+	if actualValue, ok := stack.PeekBottom(); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+	if actualValue, ok := stack.PeekBottom(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue := stack.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+}
+
+func TestStackPushBounded(t *testing.T) {
+	stack := New[int]()
+	if actualValue, ok := stack.PushBounded(1, 2); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue, ok := stack.PushBounded(2, 2); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue, ok := stack.PushBounded(3, 2); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue := stack.Values(); len(actualValue) != 2 || actualValue[0] != 3 || actualValue[1] != 2 {
+		t.Errorf("Got %v expected %v", actualValue, "[3,2]")
+	}
+	if actualValue, ok := stack.PeekBottom(); actualValue != 2 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+}
+
 func TestStackIterator(t *testing.T) {
 	stack := New[string]()
 	stack.Push("a")
@@ -127,6 +162,9 @@ func TestStackIteratorBegin(t *testing.T) {
 	for it.Next() {
 	}
 	it.Begin()
+	if value := it.Value(); value != "" {
+		t.Errorf("Got %v expected %v", value, "")
+	}
 	it.Next()
 	if index, value := it.Index(), it.Value(); index != 0 || value != "c" {
 		t.Errorf("Got %v,%v expected %v,%v", index, value, 0, "c")
@@ -37,7 +37,11 @@ type Node[T comparable, P any] struct {
 }
 
 // NewWith instantiates an AVL tree with the custom comparator.
+// Panics if comparator is nil.
 func NewWith[T comparable, P any](comparator utils.Comparator) *Tree[T, P] {
+	if comparator == nil {
+		panic("comparator must not be nil")
+	}
 	return &Tree[T, P]{Comparator: comparator}
 }
 
@@ -61,22 +65,26 @@ func (t *Tree[T, P]) Put(key T, value P) {
 // Second return parameter is true if key was found, otherwise false.
 // Key should adhere to the comparator's type assertion, otherwise method panics.
 func (t *Tree[T, P]) Get(key T) (value P, found bool) {
-	n := t.Root
-	for n != nil {
-		cmp := t.Comparator(key, n.Key)
-		switch {
-		case cmp == 0:
-			return n.Value, true
-		case cmp < 0:
-			n = n.Children[0]
-		case cmp > 0:
-			n = n.Children[1]
-		}
+	if n := t.lookup(key); n != nil {
+		return n.Value, true
 	}
 	var p P
 	return p, false
 }
 
+// UpdateValue searches the node in the tree by key and replaces its value in place with the result of f applied to the old value.
+// Returns false if key is not found in tree, in which case f is not called.
+// The tree is not rebalanced, as the key does not change.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (t *Tree[T, P]) UpdateValue(key T, f func(old P) P) bool {
+	n := t.lookup(key)
+	if n == nil {
+		return false
+	}
+	n.Value = f(n.Value)
+	return true
+}
+
 // Remove remove the node from the tree by key.
 // Key should adhere to the comparator's type assertion, otherwise method panics.
 func (t *Tree[T, P]) Remove(key T) {
@@ -103,6 +111,79 @@ func (t *Tree[T, P]) Keys() []T {
 	return keys
 }
 
+// PreOrder calls the given function once for each node, parent before its children and left before right,
+// until it returns false. Traverses with an explicit stack, e.g. to serialize or hash the shape of the tree.
+func (t *Tree[T, P]) PreOrder(f func(key T, value P) bool) {
+	if t.Root == nil {
+		return
+	}
+	stack := []*Node[T, P]{t.Root}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if !f(node.Key, node.Value) {
+			return
+		}
+		if node.Children[1] != nil {
+			stack = append(stack, node.Children[1])
+		}
+		if node.Children[0] != nil {
+			stack = append(stack, node.Children[0])
+		}
+	}
+}
+
+// PostOrder calls the given function once for each node, children before their parent and left before right,
+// until it returns false. Traverses with an explicit stack, e.g. to rebuild or release the tree bottom-up.
+func (t *Tree[T, P]) PostOrder(f func(key T, value P) bool) {
+	var stack []*Node[T, P]
+	var last *Node[T, P]
+	node := t.Root
+	for node != nil || len(stack) > 0 {
+		if node != nil {
+			stack = append(stack, node)
+			node = node.Children[0]
+			continue
+		}
+		top := stack[len(stack)-1]
+		if top.Children[1] != nil && top.Children[1] != last {
+			node = top.Children[1]
+			continue
+		}
+		if !f(top.Key, top.Value) {
+			return
+		}
+		last = top
+		stack = stack[:len(stack)-1]
+	}
+}
+
+// LevelOrder calls the given function once for each node together with its depth (0 for the root), breadth-first
+// and left before right within a level, until it returns false. Traverses with a queue, e.g. to lay out the tree by level.
+func (t *Tree[T, P]) LevelOrder(f func(key T, value P, depth int) bool) {
+	type levelNode struct {
+		node  *Node[T, P]
+		depth int
+	}
+	if t.Root == nil {
+		return
+	}
+	queue := []levelNode{{t.Root, 0}}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if !f(current.node.Key, current.node.Value, current.depth) {
+			return
+		}
+		if current.node.Children[0] != nil {
+			queue = append(queue, levelNode{current.node.Children[0], current.depth + 1})
+		}
+		if current.node.Children[1] != nil {
+			queue = append(queue, levelNode{current.node.Children[1], current.depth + 1})
+		}
+	}
+}
+
 // Values returns all values in-order based on the key.
 func (t *Tree[T, P]) Values() []P {
 	values := make([]P, t.size)
@@ -202,6 +283,22 @@ func (n *Node[T, P]) String() string {
 	return fmt.Sprintf("%v", n.Key)
 }
 
+func (t *Tree[T, P]) lookup(key T) *Node[T, P] {
+	n := t.Root
+	for n != nil {
+		cmp := t.Comparator(key, n.Key)
+		switch {
+		case cmp == 0:
+			return n
+		case cmp < 0:
+			n = n.Children[0]
+		case cmp > 0:
+			n = n.Children[1]
+		}
+	}
+	return nil
+}
+
 func (t *Tree[T, P]) put(key T, value P, p *Node[T, P], qp **Node[T, P]) bool {
 	q := *qp
 	if q == nil {
@@ -422,30 +519,51 @@ func (n *Node[T, P]) walk1(a int) *Node[T, P] {
 	return p
 }
 
+// outputFrame is a pending step of output, either a subtree to expand or a node to print.
+type outputFrame[T comparable, P any] struct {
+	node     *Node[T, P]
+	prefix   string
+	isTail   bool
+	expanded bool
+}
+
+// output renders the subtree rooted at node, right subtree on top, with an explicit stack
+// instead of recursion, so that the call stack stays bounded however deep the tree is.
 func output[T comparable, P any](node *Node[T, P], prefix string, isTail bool, str *string) {
-	if node.Children[1] != nil {
-		newPrefix := prefix
-		if isTail {
-			newPrefix += "│   "
-		} else {
-			newPrefix += "    "
+	stack := []outputFrame[T, P]{{node: node, prefix: prefix, isTail: isTail}}
+	for len(stack) > 0 {
+		frame := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		node, prefix, isTail := frame.node, frame.prefix, frame.isTail
+		if frame.expanded {
+			*str += prefix
+			if isTail {
+				*str += "└── "
+			} else {
+				*str += "┌── "
+			}
+			*str += node.String() + "\n"
+			continue
 		}
-		output(node.Children[1], newPrefix, false, str)
-	}
-	*str += prefix
-	if isTail {
-		*str += "└── "
-	} else {
-		*str += "┌── "
-	}
-	*str += node.String() + "\n"
-	if node.Children[0] != nil {
-		newPrefix := prefix
-		if isTail {
-			newPrefix += "    "
-		} else {
-			newPrefix += "│   "
+		// pushed in reverse order of printing: left subtree, node, right subtree
+		if node.Children[0] != nil {
+			newPrefix := prefix
+			if isTail {
+				newPrefix += "    "
+			} else {
+				newPrefix += "│   "
+			}
+			stack = append(stack, outputFrame[T, P]{node: node.Children[0], prefix: newPrefix, isTail: true})
+		}
+		stack = append(stack, outputFrame[T, P]{node: node, prefix: prefix, isTail: isTail, expanded: true})
+		if node.Children[1] != nil {
+			newPrefix := prefix
+			if isTail {
+				newPrefix += "│   "
+			} else {
+				newPrefix += "    "
+			}
+			stack = append(stack, outputFrame[T, P]{node: node.Children[1], prefix: newPrefix, isTail: false})
 		}
-		output(node.Children[0], newPrefix, true, str)
 	}
 }
@@ -10,6 +10,15 @@ import (
 	"github.com/lemonyxk/gods/utils"
 )
 
+func TestAVLTreeNewWithNilComparator(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "comparator must not be nil" {
+			t.Errorf("Got %v expected %v", r, "comparator must not be nil")
+		}
+	}()
+	NewWith[int, string](nil)
+}
+
 func TestAVLTreePut(t *testing.T) {
 	tree := NewWithIntComparator[int, string]()
 	tree.Put(5, "e")
@@ -52,6 +61,24 @@ func TestAVLTreePut(t *testing.T) {
 	}
 }
 
+func TestAVLTreeUpdateValue(t *testing.T) {
+	tree := NewWithIntComparator[int, int]()
+	for i := 1; i <= 10; i++ {
+		tree.Put(i, 0)
+	}
+	for _, key := range []int{3, 7, 3} {
+		if actualValue := tree.UpdateValue(key, func(old int) int { return old + 1 }); actualValue != true {
+			t.Errorf("Got %v expected %v", actualValue, true)
+		}
+	}
+	if actualValue := tree.UpdateValue(11, func(old int) int { return old + 1 }); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", tree.Values()), "[0 0 2 0 0 0 1 0 0 0]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func TestAVLTreeRemove(t *testing.T) {
 	tree := NewWithIntComparator[int, string]()
 	tree.Put(5, "e")
@@ -119,6 +146,92 @@ func TestAVLTreeRemove(t *testing.T) {
 
 }
 
+func TestAVLTreeString(t *testing.T) {
+	tree := NewWithIntComparator[int, int]()
+	if actualValue, expectedValue := tree.String(), "AVLTree\n"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	for _, key := range []int{5, 2, 8, 1, 3, 9, 4, 7, 6} {
+		tree.Put(key, key)
+	}
+	expectedValue := "AVLTree\n" +
+		"│       ┌── 9\n" +
+		"│   ┌── 8\n" +
+		"│   │   └── 7\n" +
+		"│   │       └── 6\n" +
+		"└── 5\n" +
+		"    │       ┌── 4\n" +
+		"    │   ┌── 3\n" +
+		"    └── 2\n" +
+		"        └── 1\n"
+	if actualValue := tree.String(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestAVLTreePreOrderPostOrder(t *testing.T) {
+	tree := NewWithIntComparator[int, int]()
+	tree.PreOrder(func(key int, value int) bool {
+		t.Errorf("Got %v expected no call on empty tree", key)
+		return true
+	})
+	for _, key := range []int{5, 2, 8, 1, 3, 9, 4, 7, 6} {
+		tree.Put(key, key*10)
+	}
+	var preOrder, postOrder []int
+	tree.PreOrder(func(key int, value int) bool {
+		preOrder = append(preOrder, key)
+		return true
+	})
+	tree.PostOrder(func(key int, value int) bool {
+		postOrder = append(postOrder, value/10)
+		return true
+	})
+	if actualValue, expectedValue := fmt.Sprint(preOrder), "[5 2 1 3 4 8 7 6 9]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(postOrder), "[1 4 3 2 6 7 9 8 5]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	count := 0
+	tree.PostOrder(func(key int, value int) bool {
+		count++
+		return key != 3
+	})
+	if actualValue := count; actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+}
+
+func TestAVLTreeLevelOrder(t *testing.T) {
+	tree := NewWithIntComparator[int, int]()
+	tree.LevelOrder(func(key int, value int, depth int) bool {
+		t.Errorf("Got %v expected no call on empty tree", key)
+		return true
+	})
+	for _, key := range []int{5, 2, 8, 1, 3, 9, 4, 7, 6} {
+		tree.Put(key, key*10)
+	}
+	var levelOrder []string
+	tree.LevelOrder(func(key int, value int, depth int) bool {
+		levelOrder = append(levelOrder, fmt.Sprintf("%v:%v", value/10, depth))
+		return true
+	})
+	if actualValue, expectedValue := fmt.Sprint(levelOrder), "[5:0 2:1 8:1 1:2 3:2 7:2 9:2 4:3 6:3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	count := 0
+	tree.LevelOrder(func(key int, value int, depth int) bool {
+		count++
+		return depth < 2
+	})
+	if actualValue := count; actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+}
+
 func TestAVLTreeLeftAndRight(t *testing.T) {
 	tree := NewWithIntComparator[int, string]()
 
@@ -472,6 +585,40 @@ func TestAVLTreeIterator4Prev(t *testing.T) {
 	}
 }
 
+func TestAVLTreeIteratorResetDone(t *testing.T) {
+	tree := NewWithIntComparator[int, string]()
+	tree.Put(3, "c")
+	tree.Put(1, "a")
+	tree.Put(2, "b")
+	it := tree.Iterator()
+	if actualValue := it.Done(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	it.Next()
+	it.Next()
+	if actualValue := it.Done(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	for it.Next() {
+	}
+	if actualValue := it.Done(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	it.Reset()
+	if actualValue := it.Done(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if !it.Next() || it.Key() != 1 {
+		t.Errorf("Got %v expected %v", it.Key(), 1)
+	}
+
+	it.Reset()
+	it.End()
+	if actualValue := it.Done(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
 func TestAVLTreeIteratorBegin(t *testing.T) {
 	tree := NewWithIntComparator[int, string]()
 	tree.Put(3, "c")
@@ -590,6 +737,30 @@ func TestAVLTreeSerialization(t *testing.T) {
 	assert()
 }
 
+func TestAVLTreeSerializationIntKeys(t *testing.T) {
+	tree := NewWithIntComparator[int, string]()
+	tree.Put(10, "c")
+	tree.Put(2, "b")
+	tree.Put(1, "a")
+
+	json, err := tree.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	other := NewWithIntComparator[int, string]()
+	other.Put(99, "z")
+	if err := other.FromJSON(json); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(other.Keys()), "[1 2 10]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(other.Values()), "[a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func benchmarkGet[T comparable, P any](b *testing.B, tree *Tree[int, P], size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
@@ -88,6 +88,12 @@ func (iterator *Iterator[T, P]) Key() T {
 	return iterator.node.Key
 }
 
+// KeyValue returns the current element's key and value as an entry.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[T, P]) KeyValue() containers.Entry[T, P] {
+	return containers.Entry[T, P]{Key: iterator.Key(), Value: iterator.Value()}
+}
+
 // Begin resets the iterator to its initial state (one-before-first)
 // Call Next() to fetch the first element if any.
 func (iterator *Iterator[T, P]) Begin() {
@@ -95,6 +101,19 @@ func (iterator *Iterator[T, P]) Begin() {
 	iterator.position = begin
 }
 
+// Reset resets the iterator to its initial state (one-before-first), same as Begin().
+// Call Next() to fetch the first element if any.
+func (iterator *Iterator[T, P]) Reset() {
+	iterator.Begin()
+}
+
+// Done returns true if the iterator is past the last element (one-past-the-end),
+// e.g. after Next() returned false or End() was called.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[T, P]) Done() bool {
+	return iterator.position == end
+}
+
 // End moves the iterator past the last element (one-past-the-end).
 // Call Prev() to fetch the last element if any.
 func (iterator *Iterator[T, P]) End() {
@@ -7,8 +7,8 @@ package avltree
 import (
 	"encoding/json"
 
-	"github.com/emirpasic/gods/containers"
-	"github.com/emirpasic/gods/utils"
+	"github.com/lemonyxk/gods/containers"
+	"github.com/lemonyxk/gods/utils"
 )
 
 func assertSerializationImplementation[T comparable, P any]() {
@@ -21,7 +21,11 @@ func (tree *Tree[T, P]) ToJSON() ([]byte, error) {
 	elements := make(map[string]interface{})
 	it := tree.Iterator()
 	for it.Next() {
-		elements[utils.ToString(it.Key())] = it.Value()
+		key, err := utils.ToJSONKey(it.Key())
+		if err != nil {
+			return nil, err
+		}
+		elements[key] = it.Value()
 	}
 	return json.Marshal(&elements)
 }
@@ -26,7 +26,9 @@ func assertTreeImplementation[T comparable]() {
 
 // Heap holds elements in an array-list
 type Heap[T comparable] struct {
-	list       *arraylist.List[T]
+	list *arraylist.List[T]
+	// Comparator defines the order of the heap, e.g. a reversed comparator makes it a max-heap.
+	// It can be read to build a compatible heap; replacing it on a non-empty heap breaks the heap property.
 	Comparator utils.Comparator
 }
 
@@ -76,12 +78,110 @@ func (heap *Heap[T]) Pop() (value T, ok bool) {
 	return
 }
 
+// PopTier pops the top element and then keeps popping while the next top element is in the same tier as the first one,
+// as reported by sameTier, e.g. to process all tasks of the highest priority level at once.
+// Returns the popped elements in pop order, or an empty slice if the heap is empty.
+// The remaining elements keep the heap property.
+func (heap *Heap[T]) PopTier(sameTier func(a, b T) bool) []T {
+	first, ok := heap.Pop()
+	if !ok {
+		return []T{}
+	}
+	tier := []T{first}
+	for next, ok := heap.Peek(); ok && sameTier(first, next); next, ok = heap.Peek() {
+		heap.Pop()
+		tier = append(tier, next)
+	}
+	return tier
+}
+
+// Remove removes the first found occurrence of the value from the heap and restores the heap property.
+// Returns true if the value was found and removed, otherwise false.
+// Finding the value requires a linear scan, i.e. O(n).
+func (heap *Heap[T]) Remove(value T) bool {
+	index := heap.list.IndexOf(value)
+	if index < 0 {
+		return false
+	}
+	lastIndex := heap.list.Size() - 1
+	heap.list.Swap(index, lastIndex)
+	heap.list.Remove(lastIndex)
+	if index < lastIndex {
+		heap.bubbleDownIndex(index)
+		heap.bubbleUpIndex(index)
+	}
+	return true
+}
+
+// IndexOf returns the current index of the first found occurrence of the value in the heap, or -1 if it is not found.
+// Indices are positions in the heap's array order, as returned by Values(), with the top element at 0.
+// Indices change whenever the heap is modified. Finding the value requires a linear scan, i.e. O(n).
+func (heap *Heap[T]) IndexOf(value T) int {
+	return heap.list.IndexOf(value)
+}
+
+// Fix restores the heap property after the element at the index has changed its order in place,
+// e.g. a pointer element whose priority field was modified. Takes O(log n).
+// Does not do anything if the index is out of bounds.
+func (heap *Heap[T]) Fix(index int) {
+	if !heap.withinRange(index) {
+		return
+	}
+	heap.bubbleDownIndex(index)
+	heap.bubbleUpIndex(index)
+}
+
+// Update replaces the element at the index with the value and restores the heap property in O(log n),
+// e.g. to decrease or increase a key in Dijkstra's algorithm together with IndexOf.
+// Returns false if the index is out of bounds, in which case the heap is left unchanged.
+func (heap *Heap[T]) Update(index int, value T) bool {
+	if !heap.withinRange(index) {
+		return false
+	}
+	heap.list.Set(index, value)
+	heap.Fix(index)
+	return true
+}
+
 // Peek returns top element on the heap without removing it, or nil if heap is empty.
 // Second return parameter is true, unless the heap was empty and there was nothing to peek.
 func (heap *Heap[T]) Peek() (value T, ok bool) {
 	return heap.list.Get(0)
 }
 
+// PeekTopN returns up to n elements near the top of the heap without removing them,
+// i.e. the first n elements in the heap's array order.
+// Only the first element is guaranteed to be the top, the others are an approximation of the next best
+// elements which is cheap to compute, e.g. for heuristics. The elements are not sorted.
+// For the exact top n elements in order, Pop n elements from a copy of the heap instead.
+func (heap *Heap[T]) PeekTopN(n int) []T {
+	if n <= 0 {
+		return []T{}
+	}
+	if size := heap.list.Size(); n > size {
+		n = size
+	}
+	values := make([]T, n)
+	for i := range values {
+		values[i], _ = heap.list.Get(i)
+	}
+	return values
+}
+
+// Verify checks that the heap property holds, i.e. no element is ordered before its parent by the comparator.
+// Returns false on the first violation found, e.g. when a comparator is inconsistent.
+func (heap *Heap[T]) Verify() bool {
+	size := heap.list.Size()
+	for index := 1; index < size; index++ {
+		parentValue, _ := heap.list.Get((index - 1) >> 1)
+		indexValue, _ := heap.list.Get(index)
+		if heap.Comparator(parentValue, indexValue) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
 // Empty returns true if heap does not contain any elements.
 func (heap *Heap[T]) Empty() bool {
 	return heap.list.Empty()
@@ -97,7 +197,7 @@ func (heap *Heap[T]) Clear() {
 	heap.list.Clear()
 }
 
-// Values returns all elements in the heap.
+// Values returns all elements in the heap in its array order, i.e. the element at position i has heap index i.
 func (heap *Heap[T]) Values() []T {
 	return heap.list.Values()
 }
@@ -146,7 +246,12 @@ func (heap *Heap[T]) bubbleDownIndex(index int) {
 // element (i.e. last element in the list) in its correct place so that
 // the heap maintains the min/max-heap order property.
 func (heap *Heap[T]) bubbleUp() {
-	index := heap.list.Size() - 1
+	heap.bubbleUpIndex(heap.list.Size() - 1)
+}
+
+// Performs the "bubble up" operation. This is to place the element that is at the index
+// of the heap in its correct place so that the heap maintains the min/max-heap order property.
+func (heap *Heap[T]) bubbleUpIndex(index int) {
 	for parentIndex := (index - 1) >> 1; index > 0; parentIndex = (index - 1) >> 1 {
 		indexValue, _ := heap.list.Get(index)
 		parentValue, _ := heap.list.Get(parentIndex)
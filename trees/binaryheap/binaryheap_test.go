@@ -5,8 +5,11 @@
 package binaryheap
 
 import (
+	"fmt"
 	"math/rand"
 	"testing"
+
+	"github.com/lemonyxk/gods/utils"
 )
 
 func TestBinaryHeapPush(t *testing.T) {
@@ -80,6 +83,186 @@ func TestBinaryHeapPop(t *testing.T) {
 	}
 }
 
+func TestBinaryHeapRemove(t *testing.T) {
+	heap := NewWithIntComparator[int]()
+	heap.Push(5, 3, 8, 1, 9, 2, 7)
+
+	if actualValue := heap.Remove(4); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := heap.Remove(3); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := heap.Remove(1); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := heap.Remove(9); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := heap.Size(); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	for _, expectedValue := range []int{2, 5, 7, 8} {
+		if actualValue, ok := heap.Pop(); actualValue != expectedValue || !ok {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue := heap.Remove(1); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestBinaryHeapRemoveRandom(t *testing.T) {
+	heap := NewWithIntComparator[int]()
+
+	rand.Seed(3)
+	for i := 0; i < 1000; i++ {
+		heap.Push(int(rand.Int31n(100)))
+	}
+	for i := 0; i < 500; i++ {
+		heap.Remove(int(rand.Int31n(100)))
+	}
+
+	prev, _ := heap.Pop()
+	for !heap.Empty() {
+		curr, _ := heap.Pop()
+		if prev > curr {
+			t.Errorf("Heap property invalidated. prev: %v current: %v", prev, curr)
+		}
+		prev = curr
+	}
+}
+
+func TestBinaryHeapPopTier(t *testing.T) {
+	heap := NewWithIntComparator[int]()
+	sameTier := func(a, b int) bool { return a/10 == b/10 }
+	if actualValue := len(heap.PopTier(sameTier)); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	heap.Push(21, 5, 13, 2, 17, 8, 25, 11)
+
+	// expectedTier
+	tests := []string{"[2 5 8]", "[11 13 17]", "[21 25]", "[]"}
+	for _, test := range tests {
+		if actualValue := fmt.Sprint(heap.PopTier(sameTier)); actualValue != test {
+			t.Errorf("Got %v expected %v", actualValue, test)
+		}
+		if actualValue := heap.Verify(); actualValue != true {
+			t.Errorf("Got %v expected %v", actualValue, true)
+		}
+	}
+	if actualValue := heap.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestBinaryHeapUpdateFix(t *testing.T) {
+	heap := NewWithIntComparator[int]()
+	heap.Push(5, 3, 8, 1, 9, 2)
+
+	// decrease a key to the new top
+	if actualValue := heap.Update(heap.IndexOf(8), 0); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, _ := heap.Peek(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	// increase the top key
+	heap.Update(0, 7)
+	if actualValue := heap.Verify(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := heap.IndexOf(4); actualValue != -1 {
+		t.Errorf("Got %v expected %v", actualValue, -1)
+	}
+	if actualValue := heap.Update(heap.Size(), 4); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	heap.Fix(-1)
+
+	var values []int
+	for value, ok := heap.Pop(); ok; value, ok = heap.Pop() {
+		values = append(values, value)
+	}
+	if actualValue, expectedValue := fmt.Sprint(values), "[1 2 3 5 7 9]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBinaryHeapFixPointers(t *testing.T) {
+	type task struct {
+		name     string
+		priority int
+	}
+	heap := NewWith[*task](func(a, b interface{}) int {
+		return utils.IntComparator(a.(*task).priority, b.(*task).priority)
+	})
+	a, b, c := &task{"a", 1}, &task{"b", 2}, &task{"c", 3}
+	heap.Push(a, b, c)
+
+	c.priority = 0
+	heap.Fix(heap.IndexOf(c))
+	a.priority = 4
+	heap.Fix(heap.IndexOf(a))
+
+	var names []string
+	for value, ok := heap.Pop(); ok; value, ok = heap.Pop() {
+		names = append(names, value.name)
+	}
+	if actualValue, expectedValue := fmt.Sprint(names), "[c b a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBinaryHeapPeekTopN(t *testing.T) {
+	heap := NewWithIntComparator[int]()
+	if actualValue := len(heap.PeekTopN(3)); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	heap.Push(5, 3, 8, 1, 9, 2)
+
+	top := heap.PeekTopN(3)
+	if actualValue := len(top); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue := top[0]; actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue := len(heap.PeekTopN(10)); actualValue != 6 {
+		t.Errorf("Got %v expected %v", actualValue, 6)
+	}
+	if actualValue := len(heap.PeekTopN(-1)); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue := heap.Size(); actualValue != 6 {
+		t.Errorf("Got %v expected %v", actualValue, 6)
+	}
+}
+
+func TestBinaryHeapVerify(t *testing.T) {
+	heap := NewWithIntComparator[int]()
+	if actualValue := heap.Verify(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	heap.Push(5, 3, 8, 1, 9, 2, 7)
+	if actualValue := heap.Verify(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	heap.Pop()
+	heap.Remove(8)
+	if actualValue := heap.Verify(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	// corrupt the heap by flipping the comparator
+	heap.Comparator = func(a, b interface{}) int {
+		return -utils.IntComparator(a, b)
+	}
+	if actualValue := heap.Verify(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
 func TestBinaryHeapRandom(t *testing.T) {
 	heap := NewWithIntComparator[int]()
 
@@ -193,6 +376,9 @@ func TestBinaryHeapIteratorBegin(t *testing.T) {
 	for it.Next() {
 	}
 	it.Begin()
+	if value := it.Value(); value != 0 {
+		t.Errorf("Got %v expected %v", value, 0)
+	}
 	it.Next()
 	if index, value := it.Index(), it.Value(); index != 0 || value != 1 {
 		t.Errorf("Got %v,%v expected %v,%v", index, value, 0, 1)
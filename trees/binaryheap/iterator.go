@@ -4,7 +4,10 @@
 
 package binaryheap
 
-import "github.com/lemonyxk/gods/containers"
+import (
+	"github.com/lemonyxk/gods/containers"
+	"github.com/lemonyxk/gods/utils"
+)
 
 func assertIteratorImplementation[T comparable]() {
 	var _ containers.ReverseIteratorWithIndex[T] = (*Iterator[T])(nil)
@@ -42,9 +45,12 @@ func (iterator *Iterator[T]) Prev() bool {
 	return iterator.heap.withinRange(iterator.index)
 }
 
-// Value returns the current element's value.
+// Value returns the current element's value, or nil if the iterator is not on an element.
 // Does not modify the state of the iterator.
 func (iterator *Iterator[T]) Value() T {
+	if !iterator.heap.withinRange(iterator.index) {
+		return utils.AnyEmpty[T]()
+	}
 	value, _ := iterator.heap.list.Get(iterator.index)
 	return value
 }
@@ -97,6 +97,19 @@ func (tree *Tree[T, P]) Get(key T) (value P, found bool) {
 	return utils.AnyEmpty[P](), false
 }
 
+// UpdateValue searches the entry in the tree by key and replaces its value in place with the result of f applied to the old value.
+// Returns false if key is not found in tree, in which case f is not called.
+// The tree is not rebalanced, as the key does not change.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[T, P]) UpdateValue(key T, f func(old P) P) bool {
+	node, index, found := tree.searchRecursively(tree.Root, key)
+	if !found {
+		return false
+	}
+	node.Entries[index].Value = f(node.Entries[index].Value)
+	return true
+}
+
 // Remove remove the node from the tree by key.
 // Key should adhere to the comparator's type assertion, otherwise method panics.
 func (tree *Tree[T, P]) Remove(key T) {
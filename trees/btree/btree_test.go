@@ -311,6 +311,24 @@ func TestBTreePut4(t *testing.T) {
 	assertValidTreeNode(t, tree.Root.Children[2].Children[1], 1, 0, []int{6}, true)
 }
 
+func TestBTreeUpdateValue(t *testing.T) {
+	tree := NewWithIntComparator[int, int](3)
+	for i := 1; i <= 10; i++ {
+		tree.Put(i, 0)
+	}
+	for _, key := range []int{3, 7, 3} {
+		if actualValue := tree.UpdateValue(key, func(old int) int { return old + 1 }); actualValue != true {
+			t.Errorf("Got %v expected %v", actualValue, true)
+		}
+	}
+	if actualValue := tree.UpdateValue(11, func(old int) int { return old + 1 }); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", tree.Values()), "[0 0 2 0 0 0 1 0 0 0]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func TestBTreeRemove1(t *testing.T) {
 	// empty
 	tree := NewWithIntComparator[int, int](3)
@@ -925,6 +943,40 @@ func TestBTreeIterator4Prev(t *testing.T) {
 	}
 }
 
+func TestBTreeIteratorResetDone(t *testing.T) {
+	tree := NewWithIntComparator[int, string](3)
+	tree.Put(3, "c")
+	tree.Put(1, "a")
+	tree.Put(2, "b")
+	it := tree.Iterator()
+	if actualValue := it.Done(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	it.Next()
+	it.Next()
+	if actualValue := it.Done(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	for it.Next() {
+	}
+	if actualValue := it.Done(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	it.Reset()
+	if actualValue := it.Done(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if !it.Next() || it.Key() != 1 {
+		t.Errorf("Got %v expected %v", it.Key(), 1)
+	}
+
+	it.Reset()
+	it.End()
+	if actualValue := it.Done(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
 func TestBTreeIteratorBegin(t *testing.T) {
 	tree := NewWithIntComparator[int, string](3)
 	tree.Put(3, "c")
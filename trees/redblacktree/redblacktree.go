@@ -13,6 +13,8 @@ package redblacktree
 
 import (
 	"fmt"
+	"math/bits"
+	"sync"
 
 	"github.com/lemonyxk/gods/trees"
 	"github.com/lemonyxk/gods/utils"
@@ -33,6 +35,7 @@ type Tree[T comparable, P any] struct {
 	Root       *Node[T, P]
 	size       int
 	Comparator utils.Comparator
+	pool       *sync.Pool
 }
 
 // Node is a single element within the tree
@@ -40,16 +43,55 @@ type Node[T comparable, P any] struct {
 	Key    T
 	Value  P
 	color  color
+	size   int // number of nodes in the subtree rooted at this node
 	Left   *Node[T, P]
 	Right  *Node[T, P]
 	Parent *Node[T, P]
 }
 
 // NewWith instantiates a red-black tree with the custom comparator.
+// Panics if comparator is nil.
 func NewWith[T comparable, P any](comparator utils.Comparator) *Tree[T, P] {
+	if comparator == nil {
+		panic("comparator must not be nil")
+	}
 	return &Tree[T, P]{Comparator: comparator}
 }
 
+// NewWithPool instantiates a red-black tree with the custom comparator, which allocates its nodes from the given pool.
+// Put takes nodes from the pool, while Remove, RemoveIf and Clear zero the released nodes and put them back,
+// which cuts allocations for workloads creating and discarding many short-lived trees.
+// The pool may be shared between trees of the same type and should hold *Node[T, P], if its New function is set.
+// Nodes obtained from the tree, e.g. with GetNode or iterators, must not be used after their key is removed.
+// Panics if comparator is nil.
+func NewWithPool[T comparable, P any](comparator utils.Comparator, pool *sync.Pool) *Tree[T, P] {
+	tree := NewWith[T, P](comparator)
+	tree.pool = pool
+	return tree
+}
+
+// NewFromSorted instantiates a red-black tree with the custom comparator holding the given keys and values,
+// where the i-th key is mapped to the i-th value. The keys must be sorted in strictly ascending order
+// with respect to the comparator, which lets the balanced tree be built in O(n) instead of inserting keys one by one.
+// Panics if comparator is nil, if the number of keys and values differ or if the keys are not strictly ascending.
+func NewFromSorted[T comparable, P any](comparator utils.Comparator, keys []T, values []P) *Tree[T, P] {
+	tree := NewWith[T, P](comparator)
+	if len(keys) != len(values) {
+		panic("keys and values must be of equal length")
+	}
+	nodes := make([]*Node[T, P], len(keys))
+	for i, key := range keys {
+		if i > 0 && comparator(keys[i-1], key) >= 0 {
+			panic("keys must be sorted in strictly ascending order")
+		}
+		nodes[i] = &Node[T, P]{Key: key, Value: values[i]}
+	}
+	maxDepth := bits.Len(uint(len(nodes))) - 1
+	tree.Root = build(nodes, nil, 0, maxDepth)
+	tree.size = len(nodes)
+	return tree
+}
+
 // NewWithIntComparator instantiates a red-black tree with the IntComparator, i.e. keys are of type int.
 func NewWithIntComparator[T comparable, P any]() *Tree[T, P] {
 	return &Tree[T, P]{Comparator: utils.IntComparator}
@@ -63,44 +105,49 @@ func NewWithStringComparator[T comparable, P any]() *Tree[T, P] {
 // Put inserts node into the tree.
 // Key should adhere to the comparator's type assertion, otherwise method panics.
 func (tree *Tree[T, P]) Put(key T, value P) {
-	var insertedNode *Node[T, P]
-	if tree.Root == nil {
-		// Assert key is of comparator's type for initial tree
-		tree.Comparator(key, key)
-		tree.Root = &Node[T, P]{Key: key, Value: value, color: red}
-		insertedNode = tree.Root
-	} else {
-		node := tree.Root
-		loop := true
-		for loop {
-			compare := tree.Comparator(key, node.Key)
-			switch {
-			case compare == 0:
-				node.Key = key
-				node.Value = value
-				return
-			case compare < 0:
-				if node.Left == nil {
-					node.Left = &Node[T, P]{Key: key, Value: value, color: red}
-					insertedNode = node.Left
-					loop = false
-				} else {
-					node = node.Left
-				}
-			case compare > 0:
-				if node.Right == nil {
-					node.Right = &Node[T, P]{Key: key, Value: value, color: red}
-					insertedNode = node.Right
-					loop = false
-				} else {
-					node = node.Right
-				}
-			}
+	tree.put(key, value, true)
+}
+
+// PutIfAbsent inserts node into the tree only if the key is not in the tree yet, in a single descent.
+// Returns the existing value and true if the key was found, otherwise the inserted value and false.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[T, P]) PutIfAbsent(key T, value P) (actual P, loaded bool) {
+	return tree.put(key, value, false)
+}
+
+// put inserts node into the tree, or replaces the key and value of the node with an equal key if overwrite is true.
+// Returns the value held by the node for the key afterwards and whether the key was already in the tree.
+func (tree *Tree[T, P]) put(key T, value P, overwrite bool) (actual P, loaded bool) {
+	parent, compare := tree.descend(key)
+	if compare == 0 && parent != nil {
+		if overwrite {
+			parent.Key = key
+			parent.Value = value
 		}
-		insertedNode.Parent = node
+		return parent.Value, true
+	}
+	tree.insertBelow(parent, compare, key, value)
+	return value, false
+}
+
+// Compute calls f with the current value of the key and whether the key was found in the tree,
+// then stores the value returned by f if its second return value is true, or removes the node otherwise.
+// The lookup and the insertion or removal share a single descent. Removing a key that is not in the tree does nothing.
+// f must not modify the tree.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[T, P]) Compute(key T, f func(old P, found bool) (P, bool)) {
+	parent, compare := tree.descend(key)
+	if compare == 0 && parent != nil {
+		if value, keep := f(parent.Value, true); keep {
+			parent.Value = value
+		} else {
+			tree.removeNode(parent)
+		}
+		return
+	}
+	if value, keep := f(utils.AnyEmpty[P](), false); keep {
+		tree.insertBelow(parent, compare, key, value)
 	}
-	tree.insertCase1(insertedNode)
-	tree.size++
 }
 
 // Get searches the node in the tree by key and returns its value or nil if key is not found in tree.
@@ -114,14 +161,36 @@ func (tree *Tree[T, P]) Get(key T) (value P, found bool) {
 	return utils.AnyEmpty[P](), false
 }
 
-// Remove remove the node from the tree by key.
+// GetNode searches the node in the tree by key and returns its node or nil if key is not found in tree.
 // Key should adhere to the comparator's type assertion, otherwise method panics.
-func (tree *Tree[T, P]) Remove(key T) {
-	var child *Node[T, P]
+func (tree *Tree[T, P]) GetNode(key T) *Node[T, P] {
+	return tree.lookup(key)
+}
+
+// UpdateValue searches the node in the tree by key and replaces its value in place with the result of f applied to the old value.
+// Returns false if key is not found in tree, in which case f is not called.
+// The tree is not rebalanced, as the key does not change.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[T, P]) UpdateValue(key T, f func(old P) P) bool {
 	node := tree.lookup(key)
 	if node == nil {
-		return
+		return false
+	}
+	node.Value = f(node.Value)
+	return true
+}
+
+// Remove remove the node from the tree by key.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[T, P]) Remove(key T) {
+	if node := tree.lookup(key); node != nil {
+		tree.removeNode(node)
 	}
+}
+
+// removeNode removes the node from the tree and rebalances it.
+func (tree *Tree[T, P]) removeNode(node *Node[T, P]) {
+	var child *Node[T, P]
 	if node.Left != nil && node.Right != nil {
 		pred := node.Left.maximumNode()
 		node.Key = pred.Key
@@ -134,6 +203,11 @@ func (tree *Tree[T, P]) Remove(key T) {
 		} else {
 			child = node.Right
 		}
+		// the node no longer counts, so that rotations during the fixup keep the subtree sizes right
+		node.size = child.subtreeSize()
+		for parent := node.Parent; parent != nil; parent = parent.Parent {
+			parent.size--
+		}
 		if node.color == black {
 			node.color = nodeColor(child)
 			tree.deleteCase1(node)
@@ -144,6 +218,64 @@ func (tree *Tree[T, P]) Remove(key T) {
 		}
 	}
 	tree.size--
+	tree.freeNode(node)
+}
+
+// RemoveIf removes all nodes for which the given function returns true and returns the number of removed nodes.
+//
+// When a large fraction of the tree is removed, the surviving nodes are collected in-order
+// and a balanced tree is rebuilt bottom-up in O(n), instead of removing nodes one by one with rebalancing.
+func (tree *Tree[T, P]) RemoveIf(f func(key T, value P) bool) int {
+	var survivors, removed []*Node[T, P]
+	it := tree.Iterator()
+	for it.Next() {
+		if f(it.Key(), it.Value()) {
+			removed = append(removed, it.node)
+		} else {
+			survivors = append(survivors, it.node)
+		}
+	}
+	if len(removed) == 0 {
+		return 0
+	}
+	// a few removals are cheaper than a rebuild
+	if len(removed) < tree.size/8 {
+		// keys are copied up front, as Remove may move keys between nodes and release nodes to the pool
+		keys := make([]T, len(removed))
+		for i, node := range removed {
+			keys[i] = node.Key
+		}
+		for _, key := range keys {
+			tree.Remove(key)
+		}
+		return len(removed)
+	}
+	maxDepth := bits.Len(uint(len(survivors))) - 1
+	tree.Root = build(survivors, nil, 0, maxDepth)
+	tree.size = len(survivors)
+	for _, node := range removed {
+		tree.freeNode(node)
+	}
+	return len(removed)
+}
+
+// Rebuild rebuilds the tree into a perfectly balanced shape in O(n), keeping its elements and their order.
+// The elements are copied into new nodes, taken from the pool if there is one, and the old nodes are released to the pool afterwards.
+// Nodes and iterators obtained from the tree before the rebuild must not be used afterwards.
+func (tree *Tree[T, P]) Rebuild() {
+	if tree.size == 0 {
+		return
+	}
+	nodes := make([]*Node[T, P], tree.size)
+	it := tree.Iterator()
+	for i := 0; it.Next(); i++ {
+		nodes[i] = tree.newNode(it.Key(), it.Value())
+	}
+	if tree.pool != nil {
+		tree.freeSubtree(tree.Root)
+	}
+	maxDepth := bits.Len(uint(len(nodes))) - 1
+	tree.Root = build(nodes, nil, 0, maxDepth)
 }
 
 // Empty returns true if tree does not contain any nodes
@@ -166,6 +298,90 @@ func (tree *Tree[T, P]) Keys() []T {
 	return keys
 }
 
+// EachKey calls the given function once for each key in-order until it returns false.
+// Unlike Keys(), no slice of keys is allocated, which suits scans over huge trees.
+func (tree *Tree[T, P]) EachKey(f func(key T) bool) {
+	it := tree.Iterator()
+	for it.Next() {
+		if !f(it.Key()) {
+			return
+		}
+	}
+}
+
+// PreOrder calls the given function once for each node, parent before its children and left before right,
+// until it returns false. Traverses with an explicit stack, e.g. to serialize or hash the shape of the tree.
+func (tree *Tree[T, P]) PreOrder(f func(key T, value P) bool) {
+	if tree.Root == nil {
+		return
+	}
+	stack := []*Node[T, P]{tree.Root}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if !f(node.Key, node.Value) {
+			return
+		}
+		if node.Right != nil {
+			stack = append(stack, node.Right)
+		}
+		if node.Left != nil {
+			stack = append(stack, node.Left)
+		}
+	}
+}
+
+// PostOrder calls the given function once for each node, children before their parent and left before right,
+// until it returns false. Traverses with an explicit stack, e.g. to rebuild or release the tree bottom-up.
+func (tree *Tree[T, P]) PostOrder(f func(key T, value P) bool) {
+	var stack []*Node[T, P]
+	var last *Node[T, P]
+	node := tree.Root
+	for node != nil || len(stack) > 0 {
+		if node != nil {
+			stack = append(stack, node)
+			node = node.Left
+			continue
+		}
+		top := stack[len(stack)-1]
+		if top.Right != nil && top.Right != last {
+			node = top.Right
+			continue
+		}
+		if !f(top.Key, top.Value) {
+			return
+		}
+		last = top
+		stack = stack[:len(stack)-1]
+	}
+}
+
+// LevelOrder calls the given function once for each node together with its depth (0 for the root), breadth-first
+// and left before right within a level, until it returns false. Traverses with a queue, e.g. to lay out the tree by level.
+func (tree *Tree[T, P]) LevelOrder(f func(key T, value P, depth int) bool) {
+	type levelNode struct {
+		node  *Node[T, P]
+		depth int
+	}
+	if tree.Root == nil {
+		return
+	}
+	queue := []levelNode{{tree.Root, 0}}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if !f(current.node.Key, current.node.Value, current.depth) {
+			return
+		}
+		if current.node.Left != nil {
+			queue = append(queue, levelNode{current.node.Left, current.depth + 1})
+		}
+		if current.node.Right != nil {
+			queue = append(queue, levelNode{current.node.Right, current.depth + 1})
+		}
+	}
+}
+
 // Values returns all values in-order based on the key.
 func (tree *Tree[T, P]) Values() []P {
 	values := make([]P, tree.size)
@@ -198,6 +414,49 @@ func (tree *Tree[T, P]) Right() *Node[T, P] {
 	return parent
 }
 
+// Select returns the node holding the k-th smallest key (0-based) in O(log n), using the subtree sizes kept in the nodes.
+// Second return parameter is true if such a node exists, otherwise false, i.e. if k is out of range or the tree is empty.
+func (tree *Tree[T, P]) Select(k int) (*Node[T, P], bool) {
+	if k < 0 || k >= tree.size {
+		return nil, false
+	}
+	node := tree.Root
+	for node != nil {
+		left := node.Left.subtreeSize()
+		switch {
+		case k < left:
+			node = node.Left
+		case k == left:
+			return node, true
+		default:
+			k -= left + 1
+			node = node.Right
+		}
+	}
+	return nil, false
+}
+
+// Rank returns the number of keys in the tree strictly less than the given key in O(log n),
+// i.e. the index the key has or would have in Keys(). The key itself does not need to be in the tree.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[T, P]) Rank(key T) int {
+	rank := 0
+	node := tree.Root
+	for node != nil {
+		compare := tree.Comparator(key, node.Key)
+		switch {
+		case compare == 0:
+			return rank + node.Left.subtreeSize()
+		case compare < 0:
+			node = node.Left
+		case compare > 0:
+			rank += node.Left.subtreeSize() + 1
+			node = node.Right
+		}
+	}
+	return rank
+}
+
 // Floor Finds floor node of the input key, return the floor node or nil if no floor is found.
 // Second return parameter is true if floor was found, otherwise false.
 //
@@ -258,6 +517,9 @@ func (tree *Tree[T, P]) Ceiling(key interface{}) (ceiling *Node[T, P], found boo
 
 // Clear removes all nodes from the tree.
 func (tree *Tree[T, P]) Clear() {
+	if tree.pool != nil {
+		tree.freeSubtree(tree.Root)
+	}
 	tree.Root = nil
 	tree.size = 0
 }
@@ -275,31 +537,158 @@ func (node *Node[T, P]) String() string {
 	return fmt.Sprintf("%v", node.Key)
 }
 
+// outputFrame is a pending step of output, either a subtree to expand or a node to print.
+type outputFrame[T comparable, P any] struct {
+	node     *Node[T, P]
+	prefix   string
+	isTail   bool
+	expanded bool
+}
+
+// output renders the subtree rooted at node, right subtree on top, with an explicit stack
+// instead of recursion, so that the call stack stays bounded however deep the tree is.
 func output[T comparable, P any](node *Node[T, P], prefix string, isTail bool, str *string) {
-	if node.Right != nil {
-		newPrefix := prefix
-		if isTail {
-			newPrefix += "│   "
-		} else {
-			newPrefix += "    "
+	stack := []outputFrame[T, P]{{node: node, prefix: prefix, isTail: isTail}}
+	for len(stack) > 0 {
+		frame := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		node, prefix, isTail := frame.node, frame.prefix, frame.isTail
+		if frame.expanded {
+			*str += prefix
+			if isTail {
+				*str += "└── "
+			} else {
+				*str += "┌── "
+			}
+			*str += node.String() + "\n"
+			continue
+		}
+		// pushed in reverse order of printing: left subtree, node, right subtree
+		if node.Left != nil {
+			newPrefix := prefix
+			if isTail {
+				newPrefix += "    "
+			} else {
+				newPrefix += "│   "
+			}
+			stack = append(stack, outputFrame[T, P]{node: node.Left, prefix: newPrefix, isTail: true})
+		}
+		stack = append(stack, outputFrame[T, P]{node: node, prefix: prefix, isTail: isTail, expanded: true})
+		if node.Right != nil {
+			newPrefix := prefix
+			if isTail {
+				newPrefix += "│   "
+			} else {
+				newPrefix += "    "
+			}
+			stack = append(stack, outputFrame[T, P]{node: node.Right, prefix: newPrefix, isTail: false})
 		}
-		output(node.Right, newPrefix, false, str)
 	}
-	*str += prefix
-	if isTail {
-		*str += "└── "
-	} else {
-		*str += "┌── "
+}
+
+// build links the sorted nodes into a balanced subtree and returns its root.
+// All leaves end up on the two deepest levels, so coloring the nodes on the deepest level red
+// and all other nodes black satisfies the red-black properties.
+func build[T comparable, P any](nodes []*Node[T, P], parent *Node[T, P], depth int, maxDepth int) *Node[T, P] {
+	if len(nodes) == 0 {
+		return nil
 	}
-	*str += node.String() + "\n"
-	if node.Left != nil {
-		newPrefix := prefix
-		if isTail {
-			newPrefix += "    "
-		} else {
-			newPrefix += "│   "
+	middle := len(nodes) / 2
+	node := nodes[middle]
+	node.Parent = parent
+	node.color = black
+	if depth == maxDepth && depth > 0 {
+		node.color = red
+	}
+	node.size = len(nodes)
+	node.Left = build(nodes[:middle], node, depth+1, maxDepth)
+	node.Right = build(nodes[middle+1:], node, depth+1, maxDepth)
+	return node
+}
+
+// descend searches the key from the root and returns the node holding it with a zero comparison result,
+// or otherwise the last node visited, below which the key belongs, with the result of comparing the key to it.
+// Returns a nil node for an empty tree.
+func (tree *Tree[T, P]) descend(key T) (node *Node[T, P], compare int) {
+	if tree.Root == nil {
+		// Assert key is of comparator's type for initial tree
+		tree.Comparator(key, key)
+		return nil, 0
+	}
+	node = tree.Root
+	for {
+		compare = tree.Comparator(key, node.Key)
+		var next *Node[T, P]
+		switch {
+		case compare == 0:
+			return node, 0
+		case compare < 0:
+			next = node.Left
+		default:
+			next = node.Right
 		}
-		output(node.Left, newPrefix, true, str)
+		if next == nil {
+			return node, compare
+		}
+		node = next
+	}
+}
+
+// insertBelow links a new node holding the key and value as the left (compare < 0) or right child of parent,
+// or as the root if parent is nil, and rebalances the tree.
+func (tree *Tree[T, P]) insertBelow(parent *Node[T, P], compare int, key T, value P) {
+	node := tree.newNode(key, value)
+	node.Parent = parent
+	switch {
+	case parent == nil:
+		tree.Root = node
+	case compare < 0:
+		parent.Left = node
+	default:
+		parent.Right = node
+	}
+	for ; parent != nil; parent = parent.Parent {
+		parent.size++
+	}
+	tree.insertCase1(node)
+	tree.size++
+}
+
+// newNode returns a red node holding the key and value, taken from the pool if there is one.
+func (tree *Tree[T, P]) newNode(key T, value P) *Node[T, P] {
+	if tree.pool != nil {
+		if node, ok := tree.pool.Get().(*Node[T, P]); ok && node != nil {
+			node.Key = key
+			node.Value = value
+			node.color = red
+			node.size = 1
+			return node
+		}
+	}
+	return &Node[T, P]{Key: key, Value: value, color: red, size: 1}
+}
+
+// freeNode zeroes the node and puts it back into the pool if there is one.
+// Zeroing drops the references to other nodes, keys and values held by the node.
+func (tree *Tree[T, P]) freeNode(node *Node[T, P]) {
+	if tree.pool != nil {
+		*node = Node[T, P]{}
+		tree.pool.Put(node)
+	}
+}
+
+// freeSubtree releases all nodes of the subtree to the pool.
+// Uses an explicit stack instead of recursion, so that the call stack stays bounded however deep the tree is.
+func (tree *Tree[T, P]) freeSubtree(node *Node[T, P]) {
+	stack := []*Node[T, P]{node}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if node == nil {
+			continue
+		}
+		stack = append(stack, node.Left, node.Right)
+		tree.freeNode(node)
 	}
 }
 
@@ -319,6 +708,14 @@ func (tree *Tree[T, P]) lookup(key T) *Node[T, P] {
 	return nil
 }
 
+// subtreeSize returns the number of nodes in the subtree rooted at the node, 0 for a nil node.
+func (node *Node[T, P]) subtreeSize() int {
+	if node == nil {
+		return 0
+	}
+	return node.size
+}
+
 func (node *Node[T, P]) grandparent() *Node[T, P] {
 	if node != nil && node.Parent != nil {
 		return node.Parent.Parent
@@ -352,6 +749,8 @@ func (tree *Tree[T, P]) rotateLeft(node *Node[T, P]) {
 	}
 	right.Left = node
 	node.Parent = right
+	right.size = node.size
+	node.size = 1 + node.Left.subtreeSize() + node.Right.subtreeSize()
 }
 
 func (tree *Tree[T, P]) rotateRight(node *Node[T, P]) {
@@ -363,6 +762,8 @@ func (tree *Tree[T, P]) rotateRight(node *Node[T, P]) {
 	}
 	left.Right = node
 	node.Parent = left
+	left.size = node.size
+	node.size = 1 + node.Left.subtreeSize() + node.Right.subtreeSize()
 }
 
 func (tree *Tree[T, P]) replaceNode(old *Node[T, P], new *Node[T, P]) {
@@ -6,11 +6,51 @@ package redblacktree
 
 import (
 	"fmt"
+	"math/rand"
+	"sync"
 	"testing"
 
 	"github.com/lemonyxk/gods/utils"
 )
 
+func TestRedBlackTreeNewWithNilComparator(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "comparator must not be nil" {
+			t.Errorf("Got %v expected %v", r, "comparator must not be nil")
+		}
+	}()
+	NewWith[int, string](nil)
+}
+
+func TestRedBlackTreeNewFromSorted(t *testing.T) {
+	for _, size := range []int{0, 1, 2, 7, 100} {
+		keys, values := make([]int, size), make([]string, size)
+		for i := range keys {
+			keys[i], values[i] = i*2, fmt.Sprint(i*2)
+		}
+		tree := NewFromSorted[int, string](utils.IntComparator, keys, values)
+		assertValidRedBlackTree(t, tree)
+		if actualValue := tree.Size(); actualValue != size {
+			t.Errorf("Got %v expected %v", actualValue, size)
+		}
+		for i, key := range keys {
+			if actualValue, found := tree.Get(key); actualValue != values[i] || !found {
+				t.Errorf("Got %v expected %v", actualValue, values[i])
+			}
+		}
+		tree.Put(-1, "-1")
+		tree.Remove(0)
+		assertValidRedBlackTree(t, tree)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected panic for unsorted keys")
+		}
+	}()
+	NewFromSorted[int, string](utils.IntComparator, []int{2, 1}, []string{"b", "a"})
+}
+
 func TestRedBlackTreePut(t *testing.T) {
 	tree := NewWithIntComparator[int, string]()
 	tree.Put(5, "e")
@@ -52,6 +92,24 @@ func TestRedBlackTreePut(t *testing.T) {
 	}
 }
 
+func TestRedBlackTreeUpdateValue(t *testing.T) {
+	tree := NewWithIntComparator[int, int]()
+	for i := 1; i <= 10; i++ {
+		tree.Put(i, 0)
+	}
+	for _, key := range []int{3, 7, 3} {
+		if actualValue := tree.UpdateValue(key, func(old int) int { return old + 1 }); actualValue != true {
+			t.Errorf("Got %v expected %v", actualValue, true)
+		}
+	}
+	if actualValue := tree.UpdateValue(11, func(old int) int { return old + 1 }); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", tree.Values()), "[0 0 2 0 0 0 1 0 0 0]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func TestRedBlackTreeRemove(t *testing.T) {
 	tree := NewWithIntComparator[int, string]()
 	tree.Put(5, "e")
@@ -119,6 +177,390 @@ func TestRedBlackTreeRemove(t *testing.T) {
 
 }
 
+func TestRedBlackTreeRebuild(t *testing.T) {
+	for _, size := range []int{0, 1, 2, 3, 7, 8, 100} {
+		tree := NewWithIntComparator[int, int]()
+		for i := 0; i < size; i++ {
+			tree.Put(i, i*10)
+		}
+		tree.Rebuild()
+		if actualValue := tree.Size(); actualValue != size {
+			t.Errorf("Got %v expected %v", actualValue, size)
+		}
+		for i, key := range tree.Keys() {
+			if value, _ := tree.Get(key); key != i || value != i*10 {
+				t.Errorf("Got %v,%v expected %v,%v", key, value, i, i*10)
+			}
+		}
+		if size > 0 && tree.Root.Key != size/2 {
+			t.Errorf("Got %v expected %v", tree.Root.Key, size/2)
+		}
+		assertValidRedBlackTree(t, tree)
+
+		// the tree must stay usable after a rebuild
+		tree.Put(-1, -10)
+		tree.Remove(0)
+		assertValidRedBlackTree(t, tree)
+	}
+}
+
+func TestRedBlackTreeRemoveIf(t *testing.T) {
+	for _, size := range []int{0, 1, 2, 3, 7, 8, 100, 1000} {
+		for _, modulo := range []int{1, 2, 10, 100} {
+			tree := NewWithIntComparator[int, int]()
+			for i := 0; i < size; i++ {
+				tree.Put(i, i*10)
+			}
+			removed := tree.RemoveIf(func(key int, value int) bool {
+				return key%modulo != 0
+			})
+			expectedRemoved := size - (size+modulo-1)/modulo
+			if actualValue := removed; actualValue != expectedRemoved {
+				t.Errorf("Got %v expected %v", actualValue, expectedRemoved)
+			}
+			if actualValue, expectedValue := tree.Size(), size-expectedRemoved; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+			keys := tree.Keys()
+			for i, key := range keys {
+				if key != i*modulo {
+					t.Errorf("Got %v expected %v", key, i*modulo)
+				}
+			}
+			if actualValue, found := tree.Get(modulo); size > modulo && (actualValue != modulo*10 || !found) {
+				t.Errorf("Got %v expected %v", actualValue, modulo*10)
+			}
+			assertValidRedBlackTree(t, tree)
+
+			// the tree must stay usable after a rebuild
+			tree.Put(-1, -10)
+			tree.Remove(0)
+			assertValidRedBlackTree(t, tree)
+		}
+	}
+}
+
+func TestRedBlackTreeRemoveIfFew(t *testing.T) {
+	tree := NewWithIntComparator[int, int]()
+	for i := 0; i < 1000; i++ {
+		tree.Put(i, i)
+	}
+	removed := tree.RemoveIf(func(key int, value int) bool {
+		return key%100 == 0
+	})
+	if actualValue := removed; actualValue != 10 {
+		t.Errorf("Got %v expected %v", actualValue, 10)
+	}
+	if actualValue := tree.Size(); actualValue != 990 {
+		t.Errorf("Got %v expected %v", actualValue, 990)
+	}
+	if _, found := tree.Get(500); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+	assertValidRedBlackTree(t, tree)
+}
+
+func TestRedBlackTreeWithPool(t *testing.T) {
+	pool := &sync.Pool{}
+	tree := NewWithPool[int, int](utils.IntComparator, pool)
+	native := make(map[int]int)
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 5000; i++ {
+		key := r.Intn(200)
+		switch r.Intn(3) {
+		case 0:
+			tree.Remove(key)
+			delete(native, key)
+		default:
+			tree.Put(key, i)
+			native[key] = i
+		}
+	}
+	if actualValue, expectedValue := tree.Size(), len(native); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	for key, expectedValue := range native {
+		if actualValue, found := tree.Get(key); actualValue != expectedValue || !found {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	assertValidRedBlackTree(t, tree)
+
+	removed := tree.RemoveIf(func(key int, value int) bool {
+		return key%2 == 0
+	})
+	for key := range native {
+		if key%2 == 0 {
+			delete(native, key)
+		}
+	}
+	if actualValue, expectedValue := tree.Size(), len(native); actualValue != expectedValue || removed == 0 {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	assertValidRedBlackTree(t, tree)
+
+	node := tree.Left()
+	tree.Remove(node.Key)
+	if node.Left != nil || node.Right != nil || node.Parent != nil || node.Key != 0 {
+		t.Errorf("Expected released node to be zeroed, got %v", node.Key)
+	}
+
+	tree.Clear()
+	for i := 0; i < 100; i++ {
+		tree.Put(i, i)
+	}
+	if actualValue := tree.Size(); actualValue != 100 {
+		t.Errorf("Got %v expected %v", actualValue, 100)
+	}
+	assertValidRedBlackTree(t, tree)
+}
+
+func assertValidRedBlackTree[T comparable, P any](t *testing.T, tree *Tree[T, P]) {
+	if nodeColor(tree.Root) != black {
+		t.Errorf("Root is not black")
+	}
+	var blackHeight func(node *Node[T, P]) int
+	blackHeight = func(node *Node[T, P]) int {
+		if node == nil {
+			return 1
+		}
+		if node.Left != nil && node.Left.Parent != node || node.Right != nil && node.Right.Parent != node {
+			t.Errorf("Broken parent link at %v", node.Key)
+		}
+		if node.color == red && (nodeColor(node.Left) == red || nodeColor(node.Right) == red) {
+			t.Errorf("Red node %v has a red child", node.Key)
+		}
+		if node.size != 1+node.Left.subtreeSize()+node.Right.subtreeSize() {
+			t.Errorf("Wrong subtree size at %v: %v", node.Key, node.size)
+		}
+		left, right := blackHeight(node.Left), blackHeight(node.Right)
+		if left != right {
+			t.Errorf("Black height mismatch at %v: %v != %v", node.Key, left, right)
+		}
+		if node.color == black {
+			return left + 1
+		}
+		return left
+	}
+	blackHeight(tree.Root)
+	if tree.Root.subtreeSize() != tree.Size() {
+		t.Errorf("Got %v expected %v", tree.Root.subtreeSize(), tree.Size())
+	}
+}
+
+func TestRedBlackTreePutIfAbsent(t *testing.T) {
+	m := NewWithIntComparator[int, string]()
+	m.Put(1, "a")
+
+	if actualValue, loaded := m.PutIfAbsent(1, "x"); actualValue != "a" || !loaded {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, loaded, "a", true)
+	}
+	if actualValue, loaded := m.PutIfAbsent(2, "b"); actualValue != "b" || loaded {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, loaded, "b", false)
+	}
+	if actualValue, expectedValue := fmt.Sprint(m.Keys(), m.Values()), "[1 2] [a b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestRedBlackTreeCompute(t *testing.T) {
+	tree := NewWithIntComparator[int, int]()
+	// increments the value of a key, inserting absent keys with 1, but removes keys holding 1
+	increment := func(old int, found bool) (int, bool) {
+		if found && old == 1 {
+			return 0, false
+		}
+		return old + 1, true
+	}
+	for _, key := range []int{5, 3, 8, 1, 4, 7, 9, 2, 6, 5, 8, 5} {
+		tree.Compute(key, increment)
+		assertValidRedBlackTree(t, tree)
+	}
+	if actualValue, expectedValue := fmt.Sprint(tree.Keys(), tree.Values()), "[1 2 3 4 5 6 7 9] [1 1 1 1 1 1 1 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	for _, key := range []int{1, 2, 3, 4, 6, 7, 9, 10} {
+		tree.Compute(key, increment)
+		assertValidRedBlackTree(t, tree)
+	}
+	if actualValue, expectedValue := fmt.Sprint(tree.Keys(), tree.Values()), "[5 10] [1 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	tree.Compute(11, func(old int, found bool) (int, bool) {
+		if found || old != 0 {
+			t.Errorf("Got %v,%v expected %v,%v", old, found, 0, false)
+		}
+		return 0, false
+	})
+	if actualValue := tree.Size(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+}
+
+func TestRedBlackTreeSelectRank(t *testing.T) {
+	tree := NewWithIntComparator[int, int]()
+	if node, found := tree.Select(0); node != nil || found {
+		t.Errorf("Got %v,%v expected %v,%v", node, found, nil, false)
+	}
+	if actualValue := tree.Rank(5); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+
+	for i := 0; i < 100; i++ {
+		tree.Put((i*37)%100*2, i)
+	}
+	for i := 0; i < 100; i += 3 {
+		tree.Remove(i * 2)
+	}
+	assertValidRedBlackTree(t, tree)
+
+	keys := tree.Keys()
+	for k, key := range keys {
+		if node, found := tree.Select(k); !found || node.Key != key {
+			t.Errorf("Got %v,%v expected %v,%v", node, found, key, true)
+		}
+		if actualValue := tree.Rank(key); actualValue != k {
+			t.Errorf("Got %v expected %v", actualValue, k)
+		}
+		if actualValue := tree.Rank(key + 1); actualValue != k+1 {
+			t.Errorf("Got %v expected %v", actualValue, k+1)
+		}
+	}
+	// k,expectedFound
+	tests := [][]interface{}{
+		{-1, false},
+		{len(keys), false},
+		{len(keys) - 1, true},
+	}
+	for _, test := range tests {
+		if _, found := tree.Select(test[0].(int)); found != test[1] {
+			t.Errorf("Got %v expected %v", found, test[1])
+		}
+	}
+	if actualValue := tree.Rank(-1); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue := tree.Rank(1000); actualValue != len(keys) {
+		t.Errorf("Got %v expected %v", actualValue, len(keys))
+	}
+}
+
+func TestRedBlackTreeEachKey(t *testing.T) {
+	tree := NewWithIntComparator[int, string]()
+	tree.EachKey(func(key int) bool {
+		t.Errorf("Shouldn't iterate on empty tree")
+		return true
+	})
+	tree.Put(3, "c")
+	tree.Put(1, "a")
+	tree.Put(4, "d")
+	tree.Put(2, "b")
+
+	keys := []int{}
+	tree.EachKey(func(key int) bool {
+		keys = append(keys, key)
+		return true
+	})
+	if actualValue, expectedValue := fmt.Sprintf("%v", keys), "[1 2 3 4]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	keys = []int{}
+	tree.EachKey(func(key int) bool {
+		keys = append(keys, key)
+		return key < 2
+	})
+	if actualValue, expectedValue := fmt.Sprintf("%v", keys), "[1 2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestRedBlackTreeString(t *testing.T) {
+	tree := NewWithIntComparator[int, int]()
+	if actualValue, expectedValue := tree.String(), "RedBlackTree\n"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	for _, key := range []int{5, 2, 8, 1, 3, 9, 4, 7, 6} {
+		tree.Put(key, key)
+	}
+	expectedValue := "RedBlackTree\n" +
+		"│       ┌── 9\n" +
+		"│   ┌── 8\n" +
+		"│   │   └── 7\n" +
+		"│   │       └── 6\n" +
+		"└── 5\n" +
+		"    │       ┌── 4\n" +
+		"    │   ┌── 3\n" +
+		"    └── 2\n" +
+		"        └── 1\n"
+	if actualValue := tree.String(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestRedBlackTreePreOrderPostOrder(t *testing.T) {
+	tree := NewWithIntComparator[int, int]()
+	tree.PreOrder(func(key int, value int) bool {
+		t.Errorf("Got %v expected no call on empty tree", key)
+		return true
+	})
+	for _, key := range []int{5, 2, 8, 1, 3, 9, 4, 7, 6} {
+		tree.Put(key, key*10)
+	}
+	var preOrder, postOrder []int
+	tree.PreOrder(func(key int, value int) bool {
+		preOrder = append(preOrder, key)
+		return true
+	})
+	tree.PostOrder(func(key int, value int) bool {
+		postOrder = append(postOrder, value/10)
+		return true
+	})
+	if actualValue, expectedValue := fmt.Sprint(preOrder), "[5 2 1 3 4 8 7 6 9]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(postOrder), "[1 4 3 2 6 7 9 8 5]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	count := 0
+	tree.PostOrder(func(key int, value int) bool {
+		count++
+		return key != 3
+	})
+	if actualValue := count; actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+}
+
+func TestRedBlackTreeLevelOrder(t *testing.T) {
+	tree := NewWithIntComparator[int, int]()
+	tree.LevelOrder(func(key int, value int, depth int) bool {
+		t.Errorf("Got %v expected no call on empty tree", key)
+		return true
+	})
+	for _, key := range []int{5, 2, 8, 1, 3, 9, 4, 7, 6} {
+		tree.Put(key, key*10)
+	}
+	var levelOrder []string
+	tree.LevelOrder(func(key int, value int, depth int) bool {
+		levelOrder = append(levelOrder, fmt.Sprintf("%v:%v", value/10, depth))
+		return true
+	})
+	if actualValue, expectedValue := fmt.Sprint(levelOrder), "[5:0 2:1 8:1 1:2 3:2 7:2 9:2 4:3 6:3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	count := 0
+	tree.LevelOrder(func(key int, value int, depth int) bool {
+		count++
+		return depth < 2
+	})
+	if actualValue := count; actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+}
+
 func TestRedBlackTreeLeftAndRight(t *testing.T) {
 	tree := NewWithIntComparator[int, string]()
 
@@ -472,6 +914,40 @@ func TestRedBlackTreeIterator4Prev(t *testing.T) {
 	}
 }
 
+func TestRedBlackTreeIteratorResetDone(t *testing.T) {
+	tree := NewWithIntComparator[int, string]()
+	tree.Put(3, "c")
+	tree.Put(1, "a")
+	tree.Put(2, "b")
+	it := tree.Iterator()
+	if actualValue := it.Done(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	it.Next()
+	it.Next()
+	if actualValue := it.Done(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	for it.Next() {
+	}
+	if actualValue := it.Done(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	it.Reset()
+	if actualValue := it.Done(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if !it.Next() || it.Key() != 1 {
+		t.Errorf("Got %v expected %v", it.Key(), 1)
+	}
+
+	it.Reset()
+	it.End()
+	if actualValue := it.Done(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
 func TestRedBlackTreeIteratorBegin(t *testing.T) {
 	tree := NewWithIntComparator[int, string]()
 	tree.Put(3, "c")
@@ -742,3 +1218,29 @@ func BenchmarkRedBlackTreeRemove100000(b *testing.B) {
 	b.StartTimer()
 	benchmarkRemove(b, tree, size)
 }
+
+func benchmarkPutClear(b *testing.B, tree *Tree[int, struct{}], size int) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			tree.Put(n, struct{}{})
+		}
+		tree.Clear()
+	}
+}
+
+func BenchmarkRedBlackTreePutClear1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	tree := NewWithIntComparator[int, struct{}]()
+	b.StartTimer()
+	benchmarkPutClear(b, tree, size)
+}
+
+func BenchmarkRedBlackTreePutClearWithPool1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	tree := NewWithPool[int, struct{}](utils.IntComparator, &sync.Pool{})
+	b.StartTimer()
+	benchmarkPutClear(b, tree, size)
+}
@@ -7,8 +7,8 @@ package redblacktree
 import (
 	"encoding/json"
 
-	"github.com/emirpasic/gods/containers"
-	"github.com/emirpasic/gods/utils"
+	"github.com/lemonyxk/gods/containers"
+	"github.com/lemonyxk/gods/utils"
 )
 
 func assertSerializationImplementation[T comparable, P any]() {
@@ -21,12 +21,17 @@ func (tree *Tree[T, P]) ToJSON() ([]byte, error) {
 	elements := make(map[string]interface{})
 	it := tree.Iterator()
 	for it.Next() {
-		elements[utils.ToString(it.Key())] = it.Value()
+		key, err := utils.ToJSONKey(it.Key())
+		if err != nil {
+			return nil, err
+		}
+		elements[key] = it.Value()
 	}
 	return json.Marshal(&elements)
 }
 
 // FromJSON populates the tree from the input JSON representation.
+// The tree is left untouched if the input can not be decoded.
 func (tree *Tree[T, P]) FromJSON(data []byte) error {
 	elements := make(map[T]P)
 	err := json.Unmarshal(data, &elements)
@@ -0,0 +1,148 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package syncbinaryheap implements a thread safe binary heap for producer/consumer use.
+//
+// It wraps a binaryheap.Heap with a mutex and adds PopWait, which blocks until an element
+// is available or the context is done.
+//
+// Comparator defines this heap as either min or max heap.
+//
+// Structure is thread safe.
+//
+// References: http://en.wikipedia.org/wiki/Binary_heap
+package syncbinaryheap
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/lemonyxk/gods/trees"
+	"github.com/lemonyxk/gods/trees/binaryheap"
+	"github.com/lemonyxk/gods/utils"
+)
+
+func assertTreeImplementation[T comparable]() {
+	var _ trees.Tree[T, T] = (*Heap[T])(nil)
+}
+
+// Heap holds a binary heap guarded by a mutex
+type Heap[T comparable] struct {
+	heap  *binaryheap.Heap[T]
+	mutex sync.Mutex
+	cond  *sync.Cond
+}
+
+// NewWith instantiates a new empty heap tree with the custom comparator.
+func NewWith[T comparable](comparator utils.Comparator) *Heap[T] {
+	heap := &Heap[T]{heap: binaryheap.NewWith[T](comparator)}
+	heap.cond = sync.NewCond(&heap.mutex)
+	return heap
+}
+
+// NewWithIntComparator instantiates a new empty heap with the IntComparator, i.e. elements are of type int.
+func NewWithIntComparator[T comparable]() *Heap[T] {
+	return NewWith[T](utils.IntComparator)
+}
+
+// NewWithStringComparator instantiates a new empty heap with the StringComparator, i.e. elements are of type string.
+func NewWithStringComparator[T comparable]() *Heap[T] {
+	return NewWith[T](utils.StringComparator)
+}
+
+// Push adds values onto the heap and wakes up callers waiting in PopWait.
+func (heap *Heap[T]) Push(values ...T) {
+	heap.mutex.Lock()
+	defer heap.mutex.Unlock()
+	heap.heap.Push(values...)
+	for range values {
+		heap.cond.Signal()
+	}
+}
+
+// TryPop removes top element on heap and returns it without blocking, or nil if heap is empty.
+// Second return parameter is true, unless the heap was empty and there was nothing to pop.
+func (heap *Heap[T]) TryPop() (value T, ok bool) {
+	heap.mutex.Lock()
+	defer heap.mutex.Unlock()
+	return heap.heap.Pop()
+}
+
+// PopWait removes top element on heap and returns it, blocking until an element is available.
+// Returns the context's error if the context is done before an element could be popped.
+func (heap *Heap[T]) PopWait(ctx context.Context) (value T, err error) {
+	// wake up all waiters when the context is done, each of them rechecks its own context
+	stop := context.AfterFunc(ctx, func() {
+		heap.mutex.Lock()
+		defer heap.mutex.Unlock()
+		heap.cond.Broadcast()
+	})
+	defer stop()
+
+	heap.mutex.Lock()
+	defer heap.mutex.Unlock()
+	for heap.heap.Empty() {
+		if err = ctx.Err(); err != nil {
+			return utils.AnyEmpty[T](), err
+		}
+		heap.cond.Wait()
+	}
+	// an available element is always taken, so a signal from Push is never lost on a cancelled waiter
+	value, _ = heap.heap.Pop()
+	return value, nil
+}
+
+// Peek returns top element on the heap without removing it, or nil if heap is empty.
+// Second return parameter is true, unless the heap was empty and there was nothing to peek.
+func (heap *Heap[T]) Peek() (value T, ok bool) {
+	heap.mutex.Lock()
+	defer heap.mutex.Unlock()
+	return heap.heap.Peek()
+}
+
+// Empty returns true if heap does not contain any elements.
+func (heap *Heap[T]) Empty() bool {
+	heap.mutex.Lock()
+	defer heap.mutex.Unlock()
+	return heap.heap.Empty()
+}
+
+// Size returns number of elements within the heap.
+func (heap *Heap[T]) Size() int {
+	heap.mutex.Lock()
+	defer heap.mutex.Unlock()
+	return heap.heap.Size()
+}
+
+// Clear removes all elements from the heap.
+func (heap *Heap[T]) Clear() {
+	heap.mutex.Lock()
+	defer heap.mutex.Unlock()
+	heap.heap.Clear()
+}
+
+// Values returns all elements in the heap.
+func (heap *Heap[T]) Values() []T {
+	heap.mutex.Lock()
+	defer heap.mutex.Unlock()
+	return heap.heap.Values()
+}
+
+// Comparator returns the comparator defining the order of the heap, e.g. to build a compatible heap.
+func (heap *Heap[T]) Comparator() utils.Comparator {
+	return heap.heap.Comparator
+}
+
+// String returns a string representation of container
+func (heap *Heap[T]) String() string {
+	str := "SyncBinaryHeap\n"
+	values := []string{}
+	for _, value := range heap.Values() {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syncbinaryheap
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestHeapPushTryPop(t *testing.T) {
+	heap := NewWithIntComparator[int]()
+	if _, ok := heap.TryPop(); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	heap.Push(3, 1)
+	heap.Push(2)
+	if actualValue := heap.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	for _, expectedValue := range []int{1, 2, 3} {
+		if actualValue, ok := heap.TryPop(); actualValue != expectedValue || !ok {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue := heap.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestHeapComparator(t *testing.T) {
+	heap := NewWithIntComparator[int]()
+	if actualValue := heap.Comparator()(1, 2); actualValue != -1 {
+		t.Errorf("Got %v expected %v", actualValue, -1)
+	}
+}
+
+func TestHeapPopWait(t *testing.T) {
+	heap := NewWithIntComparator[int]()
+	result := make(chan int)
+	go func() {
+		value, err := heap.PopWait(context.Background())
+		if err != nil {
+			t.Errorf("Got error %v", err)
+		}
+		result <- value
+	}()
+	time.Sleep(10 * time.Millisecond)
+	heap.Push(5)
+	if actualValue := <-result; actualValue != 5 {
+		t.Errorf("Got %v expected %v", actualValue, 5)
+	}
+}
+
+func TestHeapPopWaitCancel(t *testing.T) {
+	heap := NewWithIntComparator[int]()
+	ctx, cancel := context.WithCancel(context.Background())
+	result := make(chan error)
+	go func() {
+		_, err := heap.PopWait(ctx)
+		result <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	if actualValue := <-result; actualValue != context.Canceled {
+		t.Errorf("Got %v expected %v", actualValue, context.Canceled)
+	}
+
+	heap.Push(1)
+	if _, err := heap.PopWait(ctx); err != nil {
+		t.Errorf("Got error %v, available element should be popped", err)
+	}
+}
+
+func TestHeapProducerConsumer(t *testing.T) {
+	heap := NewWithIntComparator[int]()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	producers, consumers, count := 4, 4, 1000
+	var wg sync.WaitGroup
+	popped := make(chan int, producers*count)
+	for i := 0; i < consumers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				value, err := heap.PopWait(ctx)
+				if err != nil {
+					return
+				}
+				popped <- value
+			}
+		}()
+	}
+	for i := 0; i < producers; i++ {
+		go func() {
+			for n := 0; n < count; n++ {
+				heap.Push(n)
+			}
+		}()
+	}
+
+	timeout := time.After(5 * time.Second)
+	for received := 0; received < producers*count; received++ {
+		select {
+		case <-popped:
+		case <-timeout:
+			t.Fatalf("Got %v elements expected %v", received, producers*count)
+		}
+	}
+	cancel()
+	wg.Wait()
+	if actualValue := heap.Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package trie
+
+import (
+	"encoding/json"
+
+	"github.com/lemonyxk/gods/containers"
+)
+
+func assertSerializationImplementation[P any]() {
+	var _ containers.JSONSerializer = (*Tree[P])(nil)
+	var _ containers.JSONDeserializer = (*Tree[P])(nil)
+}
+
+// ToJSON outputs the JSON representation of the trie.
+func (tree *Tree[P]) ToJSON() ([]byte, error) {
+	elements := make(map[string]P)
+	for _, entry := range tree.WithPrefix("") {
+		elements[entry.Key] = entry.Value
+	}
+	return json.Marshal(&elements)
+}
+
+// FromJSON populates the trie from the input JSON representation.
+func (tree *Tree[P]) FromJSON(data []byte) error {
+	elements := make(map[string]P)
+	err := json.Unmarshal(data, &elements)
+	if err == nil {
+		tree.Clear()
+		for key, value := range elements {
+			tree.Insert(key, value)
+		}
+	}
+	return err
+}
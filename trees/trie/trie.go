@@ -0,0 +1,204 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package trie implements a prefix tree (trie) with string keys.
+//
+// Keys are split into bytes, so all keys sharing a prefix share the path from the root to that prefix.
+// Keys are ordered lexicographically by their bytes, i.e. the same order as utils.StringComparator.
+//
+// Structure is not thread safe.
+//
+// References: https://en.wikipedia.org/wiki/Trie
+package trie
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/lemonyxk/gods/containers"
+	"github.com/lemonyxk/gods/trees"
+	"github.com/lemonyxk/gods/utils"
+)
+
+func assertTreeImplementation[P any]() {
+	var _ trees.Tree[string, P] = (*Tree[P])(nil)
+}
+
+// Tree holds elements of the trie
+type Tree[P any] struct {
+	root *node[P]
+	size int
+}
+
+type node[P any] struct {
+	value    P
+	hasValue bool
+	children map[byte]*node[P]
+}
+
+// New instantiates an empty trie.
+func New[P any]() *Tree[P] {
+	return &Tree[P]{root: newNode[P]()}
+}
+
+// Insert inserts the key-value pair into the trie, overwriting the value if the key already exists.
+func (tree *Tree[P]) Insert(key string, value P) {
+	n := tree.root
+	for i := 0; i < len(key); i++ {
+		child, ok := n.children[key[i]]
+		if !ok {
+			child = newNode[P]()
+			n.children[key[i]] = child
+		}
+		n = child
+	}
+	if !n.hasValue {
+		tree.size++
+	}
+	n.value = value
+	n.hasValue = true
+}
+
+// Get searches the key in the trie and returns its value or nil if key is not found in trie.
+// Second return parameter is true if key was found, otherwise false.
+func (tree *Tree[P]) Get(key string) (value P, found bool) {
+	n := tree.lookup(key)
+	if n == nil || !n.hasValue {
+		return utils.AnyEmpty[P](), false
+	}
+	return n.value, true
+}
+
+// Delete removes the key from the trie and prunes the nodes no longer leading to any key.
+func (tree *Tree[P]) Delete(key string) {
+	path := make([]*node[P], 0, len(key)+1)
+	n := tree.root
+	path = append(path, n)
+	for i := 0; i < len(key); i++ {
+		child, ok := n.children[key[i]]
+		if !ok {
+			return
+		}
+		n = child
+		path = append(path, n)
+	}
+	if !n.hasValue {
+		return
+	}
+	n.value = utils.AnyEmpty[P]()
+	n.hasValue = false
+	tree.size--
+	for i := len(path) - 1; i > 0; i-- {
+		if path[i].hasValue || len(path[i].children) > 0 {
+			break
+		}
+		delete(path[i-1].children, key[i-1])
+	}
+}
+
+// WithPrefix returns all key-value pairs whose keys start with the given prefix, in lexicographic order of the keys.
+// Returns all key-value pairs if prefix is empty.
+func (tree *Tree[P]) WithPrefix(prefix string) []containers.Entry[string, P] {
+	entries := []containers.Entry[string, P]{}
+	if n := tree.lookup(prefix); n != nil {
+		n.collect([]byte(prefix), &entries)
+	}
+	return entries
+}
+
+// LongestPrefix finds the longest key in the trie which is a prefix of s and returns that key with its value.
+// Third return parameter is true if such a key was found, otherwise false.
+func (tree *Tree[P]) LongestPrefix(s string) (key string, value P, found bool) {
+	n := tree.root
+	if n.hasValue {
+		key, value, found = "", n.value, true
+	}
+	for i := 0; i < len(s); i++ {
+		child, ok := n.children[s[i]]
+		if !ok {
+			break
+		}
+		n = child
+		if n.hasValue {
+			key, value, found = s[:i+1], n.value, true
+		}
+	}
+	return
+}
+
+// Empty returns true if trie does not contain any keys.
+func (tree *Tree[P]) Empty() bool {
+	return tree.size == 0
+}
+
+// Size returns number of keys in the trie.
+func (tree *Tree[P]) Size() int {
+	return tree.size
+}
+
+// Keys returns all keys in lexicographic order.
+func (tree *Tree[P]) Keys() []string {
+	keys := make([]string, 0, tree.size)
+	for _, entry := range tree.WithPrefix("") {
+		keys = append(keys, entry.Key)
+	}
+	return keys
+}
+
+// Values returns all values in lexicographic order based on the key.
+func (tree *Tree[P]) Values() []P {
+	values := make([]P, 0, tree.size)
+	for _, entry := range tree.WithPrefix("") {
+		values = append(values, entry.Value)
+	}
+	return values
+}
+
+// Clear removes all keys from the trie.
+func (tree *Tree[P]) Clear() {
+	tree.root = newNode[P]()
+	tree.size = 0
+}
+
+// String returns a string representation of container
+func (tree *Tree[P]) String() string {
+	str := "Trie\n"
+	values := []string{}
+	for _, entry := range tree.WithPrefix("") {
+		values = append(values, fmt.Sprintf("%v:%v", entry.Key, entry.Value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+func newNode[P any]() *node[P] {
+	return &node[P]{children: make(map[byte]*node[P])}
+}
+
+func (tree *Tree[P]) lookup(key string) *node[P] {
+	n := tree.root
+	for i := 0; i < len(key); i++ {
+		child, ok := n.children[key[i]]
+		if !ok {
+			return nil
+		}
+		n = child
+	}
+	return n
+}
+
+func (n *node[P]) collect(prefix []byte, entries *[]containers.Entry[string, P]) {
+	if n.hasValue {
+		*entries = append(*entries, containers.Entry[string, P]{Key: string(prefix), Value: n.value})
+	}
+	labels := make([]byte, 0, len(n.children))
+	for label := range n.children {
+		labels = append(labels, label)
+	}
+	sort.Slice(labels, func(i, j int) bool { return labels[i] < labels[j] })
+	for _, label := range labels {
+		n.children[label].collect(append(prefix, label), entries)
+	}
+}
@@ -0,0 +1,231 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package trie
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTrieInsert(t *testing.T) {
+	tree := New[int]()
+	tree.Insert("tea", 3)
+	tree.Insert("ten", 4)
+	tree.Insert("to", 2)
+	tree.Insert("inn", 6)
+	tree.Insert("in", 5)
+	tree.Insert("a", 9)
+	tree.Insert("a", 1) // overwrite
+
+	if actualValue := tree.Size(); actualValue != 6 {
+		t.Errorf("Got %v expected %v", actualValue, 6)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", tree.Keys()), "[a in inn tea ten to]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", tree.Values()), "[1 5 6 3 4 2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	tests1 := [][]interface{}{
+		{"a", 1, true},
+		{"in", 5, true},
+		{"inn", 6, true},
+		{"tea", 3, true},
+		{"ten", 4, true},
+		{"to", 2, true},
+		{"t", 0, false},
+		{"te", 0, false},
+		{"teas", 0, false},
+		{"", 0, false},
+	}
+
+	for _, test := range tests1 {
+		// retrievals
+		actualValue, actualFound := tree.Get(test[0].(string))
+		if actualValue != test[1] || actualFound != test[2] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+	}
+}
+
+func TestTrieDelete(t *testing.T) {
+	tree := New[int]()
+	tree.Insert("tea", 3)
+	tree.Insert("ten", 4)
+	tree.Insert("to", 2)
+	tree.Insert("te", 7)
+
+	tree.Delete("t")
+	tree.Delete("teas")
+	tree.Delete("x")
+	if actualValue := tree.Size(); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+
+	tree.Delete("te")
+	if actualValue, expectedValue := fmt.Sprintf("%v", tree.Keys()), "[tea ten to]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	tree.Delete("tea")
+	tree.Delete("tea")
+	if actualValue, expectedValue := fmt.Sprintf("%v", tree.Keys()), "[ten to]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if _, ok := tree.root.children['t'].children['e'].children['a']; ok {
+		t.Errorf("Expected node of deleted key to be pruned")
+	}
+
+	tree.Delete("ten")
+	tree.Delete("to")
+	if actualValue := tree.Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue := tree.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := len(tree.root.children); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func TestTrieWithPrefix(t *testing.T) {
+	tree := New[int]()
+	tree.Insert("tea", 3)
+	tree.Insert("ten", 4)
+	tree.Insert("to", 2)
+	tree.Insert("te", 7)
+	tree.Insert("inn", 6)
+
+	tests := [][]interface{}{
+		{"te", "[{te 7} {tea 3} {ten 4}]"},
+		{"t", "[{te 7} {tea 3} {ten 4} {to 2}]"},
+		{"tea", "[{tea 3}]"},
+		{"teas", "[]"},
+		{"x", "[]"},
+		{"", "[{inn 6} {te 7} {tea 3} {ten 4} {to 2}]"},
+	}
+
+	for _, test := range tests {
+		if actualValue, expectedValue := fmt.Sprintf("%v", tree.WithPrefix(test[0].(string))), test[1]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestTrieLongestPrefix(t *testing.T) {
+	tree := New[string]()
+	if _, _, found := tree.LongestPrefix("anything"); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+
+	tree.Insert("192.168", "lan")
+	tree.Insert("192.168.1", "office")
+	tree.Insert("10", "vpn")
+
+	tests := [][]interface{}{
+		{"192.168.1.15", "192.168.1", "office", true},
+		{"192.168.2.15", "192.168", "lan", true},
+		{"192.168", "192.168", "lan", true},
+		{"192.16", "", "", false},
+		{"10.0.0.1", "10", "vpn", true},
+		{"", "", "", false},
+	}
+
+	for _, test := range tests {
+		key, value, found := tree.LongestPrefix(test[0].(string))
+		if key != test[1] || value != test[2] || found != test[3] {
+			t.Errorf("Got %v,%v,%v expected %v,%v,%v", key, value, found, test[1], test[2], test[3])
+		}
+	}
+
+	tree.Insert("", "default")
+	if key, value, found := tree.LongestPrefix("172.16"); key != "" || value != "default" || !found {
+		t.Errorf("Got %v,%v,%v expected %v,%v,%v", key, value, found, "", "default", true)
+	}
+}
+
+func TestTrieClear(t *testing.T) {
+	tree := New[int]()
+	tree.Insert("a", 1)
+	tree.Insert("b", 2)
+	tree.Clear()
+	if actualValue := tree.Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if _, found := tree.Get("a"); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+}
+
+func TestTrieSerialization(t *testing.T) {
+	tree := New[int]()
+	tree.Insert("c", 3)
+	tree.Insert("b", 2)
+	tree.Insert("a", 1)
+
+	var err error
+	assert := func() {
+		if actualValue, expectedValue := fmt.Sprintf("%v", tree.Keys()), "[a b c]"; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := fmt.Sprintf("%v", tree.Values()), "[1 2 3]"; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := tree.Size(), 3; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if err != nil {
+			t.Errorf("Got error %v", err)
+		}
+	}
+
+	assert()
+
+	json, err := tree.ToJSON()
+	assert()
+
+	err = tree.FromJSON(json)
+	assert()
+}
+
+func benchmarkGet(b *testing.B, tree *Tree[struct{}], keys []string) {
+	for i := 0; i < b.N; i++ {
+		for _, key := range keys {
+			tree.Get(key)
+		}
+	}
+}
+
+func benchmarkInsert(b *testing.B, tree *Tree[struct{}], keys []string) {
+	for i := 0; i < b.N; i++ {
+		for _, key := range keys {
+			tree.Insert(key, struct{}{})
+		}
+	}
+}
+
+func BenchmarkTrieGet10000(b *testing.B) {
+	b.StopTimer()
+	keys := make([]string, 10000)
+	tree := New[struct{}]()
+	for n := range keys {
+		keys[n] = fmt.Sprintf("key%d", n)
+		tree.Insert(keys[n], struct{}{})
+	}
+	b.StartTimer()
+	benchmarkGet(b, tree, keys)
+}
+
+func BenchmarkTrieInsert10000(b *testing.B) {
+	b.StopTimer()
+	keys := make([]string, 10000)
+	for n := range keys {
+		keys[n] = fmt.Sprintf("key%d", n)
+	}
+	tree := New[struct{}]()
+	b.StartTimer()
+	benchmarkInsert(b, tree, keys)
+}
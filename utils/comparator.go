@@ -4,7 +4,11 @@
 
 package utils
 
-import "time"
+import (
+	"bytes"
+	"cmp"
+	"time"
+)
 
 // Comparator will make type assertion (see IntComparator for example),
 // which will panic if a or b are not of the asserted type.
@@ -39,6 +43,11 @@ func StringComparator(a, b interface{}) int {
 	return 0
 }
 
+// BytesComparator provides a lexicographic comparison on []byte
+func BytesComparator(a, b interface{}) int {
+	return bytes.Compare(a.([]byte), b.([]byte))
+}
+
 // IntComparator provides a basic comparison on int
 func IntComparator(a, b interface{}) int {
 	aAsserted := a.(int)
@@ -235,6 +244,14 @@ func RuneComparator(a, b interface{}) int {
 	}
 }
 
+// OrderedComparator returns a comparator for any ordered type, e.g. int64 or a named string type.
+// Comparison follows cmp.Compare, i.e. NaN is less than any other floating-point value.
+func OrderedComparator[T cmp.Ordered]() Comparator {
+	return func(a, b interface{}) int {
+		return cmp.Compare(a.(T), b.(T))
+	}
+}
+
 // TimeComparator provides a basic comparison on time.Time
 func TimeComparator(a, b interface{}) int {
 	aAsserted := a.(time.Time)
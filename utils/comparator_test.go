@@ -5,6 +5,7 @@
 package utils
 
 import (
+	"math"
 	"testing"
 	"time"
 )
@@ -54,6 +55,57 @@ func TestStringComparator(t *testing.T) {
 	}
 }
 
+func TestBytesComparator(t *testing.T) {
+
+	// b1,b2,expected
+	tests := [][]interface{}{
+		{[]byte("a"), []byte("a"), 0},
+		{[]byte("a"), []byte("b"), -1},
+		{[]byte("b"), []byte("a"), 1},
+		{[]byte("aa"), []byte("aab"), -1},
+		{[]byte{}, []byte{}, 0},
+		{[]byte("a"), []byte{}, 1},
+		{[]byte{}, []byte("a"), -1},
+		{[]byte{0x00, 0xff}, []byte{0x01}, -1},
+	}
+
+	for _, test := range tests {
+		actual := BytesComparator(test[0], test[1])
+		expected := test[2]
+		if actual != expected {
+			t.Errorf("Got %v expected %v", actual, expected)
+		}
+	}
+}
+
+func TestOrderedComparator(t *testing.T) {
+
+	// i1,i2,expected
+	tests := [][]interface{}{
+		{int64(1), int64(1), 0},
+		{int64(1), int64(2), -1},
+		{int64(2), int64(1), 1},
+		{int64(-1 << 62), int64(1 << 62), -1},
+	}
+
+	comparator := OrderedComparator[int64]()
+	for _, test := range tests {
+		actual := comparator(test[0], test[1])
+		expected := test[2]
+		if actual != expected {
+			t.Errorf("Got %v expected %v", actual, expected)
+		}
+	}
+
+	type name string
+	if actual := OrderedComparator[name]()(name("a"), name("b")); actual != -1 {
+		t.Errorf("Got %v expected %v", actual, -1)
+	}
+	if actual := OrderedComparator[float64]()(math.NaN(), 0.0); actual != -1 {
+		t.Errorf("Got %v expected %v", actual, -1)
+	}
+}
+
 func TestTimeComparator(t *testing.T) {
 
 	now := time.Now()
@@ -13,6 +13,49 @@ func Sort[P any](values []P, comparator Comparator) {
 	sort.Sort(sortable[P]{values, comparator})
 }
 
+// SortStable sorts values (in-place) with respect to the given comparator,
+// keeping the original order of values the comparator reports as equal.
+//
+// Uses Go's stable sort (insertion sort on blocks followed by symmerge), which is slower than Sort.
+func SortStable[P any](values []P, comparator Comparator) {
+	sort.Stable(sortable[P]{values, comparator})
+}
+
+// Reverse reverses the order of values (in-place), e.g. to turn an ascending sort into a descending one.
+func Reverse[P any](values []P) {
+	for i, j := 0, len(values)-1; i < j; i, j = i+1, j-1 {
+		values[i], values[j] = values[j], values[i]
+	}
+}
+
+// Search searches target in values (sorted with respect to the given comparator) using binary search.
+// Returns the index of the first element equal to target and true if found,
+// otherwise the index where target would be inserted to keep values sorted and false.
+//
+// Mirrors sort.Search semantics, i.e. the returned index is within [0, len(values)].
+func Search[P any](values []P, target P, comparator Comparator) (index int, found bool) {
+	index = sort.Search(len(values), func(i int) bool {
+		return comparator(values[i], target) >= 0
+	})
+	return index, index < len(values) && comparator(values[index], target) == 0
+}
+
+// Partition rearranges values (in-place) so that all elements less than pivot with respect to the given comparator
+// come first, and returns the number of those elements, i.e. the index of the first element not less than pivot.
+// The pivot does not need to be an element of values. The order within both parts is not preserved.
+//
+// Uses Lomuto's partition scheme in a single pass, e.g. as a building block for quickselect.
+func Partition[P any](values []P, pivot P, comparator Comparator) int {
+	index := 0
+	for i := range values {
+		if comparator(values[i], pivot) < 0 {
+			values[index], values[i] = values[i], values[index]
+			index++
+		}
+	}
+	return index
+}
+
 type sortable[P any] struct {
 	values     []P
 	comparator Comparator
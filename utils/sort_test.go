@@ -26,6 +26,73 @@ func TestSortInts(t *testing.T) {
 
 }
 
+func TestReverse(t *testing.T) {
+	tests := [][]int{{}, {1}, {1, 2}, {1, 2, 3}, {1, 2, 3, 4}}
+	for _, values := range tests {
+		reversed := append([]int(nil), values...)
+		Reverse(reversed)
+		for i := range values {
+			if actualValue, expectedValue := reversed[i], values[len(values)-1-i]; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		}
+	}
+}
+
+func TestSearch(t *testing.T) {
+	values := []int{1, 3, 3, 5, 7}
+
+	// target,expectedIndex,expectedFound
+	tests := [][]interface{}{
+		{0, 0, false},
+		{1, 0, true},
+		{2, 1, false},
+		{3, 1, true},
+		{4, 3, false},
+		{7, 4, true},
+		{8, 5, false},
+	}
+
+	for _, test := range tests {
+		index, found := Search(values, test[0].(int), IntComparator)
+		if index != test[1] || found != test[2] {
+			t.Errorf("Got %v,%v expected %v,%v", index, found, test[1], test[2])
+		}
+	}
+
+	if index, found := Search([]string{}, "a", StringComparator); index != 0 || found {
+		t.Errorf("Got %v,%v expected %v,%v", index, found, 0, false)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	// pivot,expectedIndex
+	tests := [][]interface{}{
+		{0, 0},
+		{1, 0},
+		{4, 3},
+		{5, 4},
+		{10, 9},
+	}
+
+	for _, test := range tests {
+		values := []int{5, 1, 9, 3, 7, 2, 8, 4, 6}
+		index := Partition(values, test[0].(int), IntComparator)
+		if index != test[1] {
+			t.Errorf("Got %v expected %v", index, test[1])
+		}
+		for i, value := range values {
+			if less := value < test[0].(int); less != (i < index) {
+				t.Errorf("Got %v at %v for pivot %v", value, i, test[0])
+			}
+		}
+	}
+
+	if index := Partition([]string{}, "a", StringComparator); index != 0 {
+		t.Errorf("Got %v expected %v", index, 0)
+	}
+}
+
 func TestSortStrings(t *testing.T) {
 
 	strings := []interface{}{}
@@ -79,6 +146,28 @@ func TestSortStructs(t *testing.T) {
 	}
 }
 
+func TestSortStable(t *testing.T) {
+	type User struct {
+		id   int
+		name string
+	}
+
+	byID := func(a, b interface{}) int {
+		return IntComparator(a.(User).id, b.(User).id)
+	}
+
+	users := []User{{2, "a"}, {1, "b"}, {2, "c"}, {1, "d"}, {2, "e"}, {1, "f"}}
+
+	SortStable(users, byID)
+
+	expected := []User{{1, "b"}, {1, "d"}, {1, "f"}, {2, "a"}, {2, "c"}, {2, "e"}}
+	for i := range users {
+		if users[i] != expected[i] {
+			t.Errorf("Got %v expected %v", users[i], expected[i])
+		}
+	}
+}
+
 func TestSortRandom(t *testing.T) {
 	ints := []interface{}{}
 	for i := 0; i < 10000; i++ {
@@ -10,6 +10,7 @@
 package utils
 
 import (
+	"encoding"
 	"fmt"
 	"strconv"
 )
@@ -46,6 +47,21 @@ func ToString(value interface{}) string {
 	}
 }
 
+// ToJSONKey converts a value to the string used as its key in JSON objects.
+// Values implementing encoding.TextMarshaler are converted with MarshalText, so that they
+// round-trip through encoding/json, which parses such keys with encoding.TextUnmarshaler.
+// All other values are converted with ToString.
+func ToJSONKey(value interface{}) (string, error) {
+	if marshaler, ok := value.(encoding.TextMarshaler); ok {
+		text, err := marshaler.MarshalText()
+		if err != nil {
+			return "", err
+		}
+		return string(text), nil
+	}
+	return ToString(value), nil
+}
+
 func ToAny[T any](r []T) []any {
 	var res []any
 	for i := 0; i < len(r); i++ {
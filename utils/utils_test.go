@@ -5,6 +5,7 @@
 package utils
 
 import (
+	"errors"
 	"strings"
 	"testing"
 )
@@ -102,3 +103,34 @@ func TestToStringOther(t *testing.T) {
 		t.Errorf("Got %v expected %v", actualValue, expectedValue)
 	}
 }
+
+type textKey struct {
+	prefix string
+	id     int
+}
+
+func (key textKey) MarshalText() ([]byte, error) {
+	if key.prefix == "" {
+		return nil, errors.New("empty prefix")
+	}
+	return []byte(key.prefix + "-" + ToString(key.id)), nil
+}
+
+func TestToJSONKey(t *testing.T) {
+	tests := [][]interface{}{
+		{"abc", "abc"},
+		{42, "42"},
+		{textKey{"user", 7}, "user-7"},
+	}
+
+	for _, test := range tests {
+		actualValue, err := ToJSONKey(test[0])
+		if actualValue != test[1] || err != nil {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+	}
+
+	if _, err := ToJSONKey(textKey{}); err == nil {
+		t.Errorf("Got %v expected %v", err, "empty prefix")
+	}
+}